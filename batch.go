@@ -0,0 +1,202 @@
+package zoho
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaxRecordsPerRequest is the maximum number of records the CRM and Recruit APIs accept in a single
+// insert, update, upsert or delete request
+const MaxRecordsPerRequest = 100
+
+// BatchOptions controls how a large multi-record write is split into requests
+type BatchOptions struct {
+	// Size is the number of records sent per request, defaults to (and is capped at) MaxRecordsPerRequest
+	Size int
+	// Concurrency is the number of requests in flight at the same time, defaults to 1.
+	// Zoho limits concurrent calls per organization, keep this low for shared accounts.
+	Concurrency int
+	// Delay is waited before each request is sent by a worker, it can be used to stay under the per minute limit
+	Delay time.Duration
+}
+
+// RecordsStatusResponse is the per-record status returned by multi-record write requests
+type RecordsStatusResponse struct {
+	Data []RecordStatus `json:"data,omitempty"`
+	// Code, Status and Message are set when the whole request was rejected
+	Code    string `json:"code,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// RecordStatus is the status of a single record in a multi-record write response
+type RecordStatus struct {
+	Code           string          `json:"code,omitempty"`
+	Status         string          `json:"status,omitempty"`
+	Message        string          `json:"message,omitempty"`
+	Action         string          `json:"action,omitempty"`
+	DuplicateField string          `json:"duplicate_field,omitempty"`
+	Details        json.RawMessage `json:"details,omitempty"`
+}
+
+// RecordResult is the outcome of writing the record found at Index in the input
+type RecordResult struct {
+	Index int
+	// ID of the created, updated or deleted record, empty on failure
+	ID string
+	// Action is "insert" or "update" for upserts
+	Action string
+	Err    error
+}
+
+// RecordError is the error reported by Zoho for a single record, it mirrors the code and details of the response
+type RecordError struct {
+	Index            int
+	Code             string
+	Message          string
+	APIName          string
+	ExpectedDataType string
+}
+
+func (e *RecordError) Error() string {
+	msg := fmt.Sprintf("record %d: %s: %s", e.Index, e.Code, e.Message)
+	if e.APIName != "" {
+		msg += fmt.Sprintf(" (field %s", e.APIName)
+		if e.ExpectedDataType != "" {
+			msg += fmt.Sprintf(", expected %s", e.ExpectedDataType)
+		}
+		msg += ")"
+	}
+	return msg
+}
+
+// BatchResult is the merged outcome of every request made for a batched write, Records is ordered by input index
+type BatchResult struct {
+	Records []RecordResult
+}
+
+// Succeeded returns the results of the records which were written
+func (r BatchResult) Succeeded() []RecordResult {
+	var ok []RecordResult
+	for _, rec := range r.Records {
+		if rec.Err == nil {
+			ok = append(ok, rec)
+		}
+	}
+	return ok
+}
+
+// Failed returns the results of the records which were not written
+func (r BatchResult) Failed() []RecordResult {
+	var failed []RecordResult
+	for _, rec := range r.Records {
+		if rec.Err != nil {
+			failed = append(failed, rec)
+		}
+	}
+	return failed
+}
+
+// Err returns nil when every record was written, otherwise an error summarising the failures
+func (r BatchResult) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, 3)
+	for i, rec := range failed {
+		if i == 3 {
+			msgs = append(msgs, "...")
+			break
+		}
+		msgs = append(msgs, rec.Err.Error())
+	}
+	return fmt.Errorf("%d of %d records failed: %s", len(failed), len(r.Records), strings.Join(msgs, "; "))
+}
+
+// RunBatches splits total records into batches according to opts and calls send for each [start, end) range,
+// running up to opts.Concurrency batches at once. send returns the status of every record of its batch in order,
+// when send returns an error every record of the batch is marked as failed with that error.
+func RunBatches(total int, opts BatchOptions, send func(start, end int) ([]RecordStatus, error)) BatchResult {
+	size := opts.Size
+	if size <= 0 || size > MaxRecordsPerRequest {
+		size = MaxRecordsPerRequest
+	}
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = 1
+	}
+
+	result := BatchResult{Records: make([]RecordResult, total)}
+
+	type batch struct{ start, end int }
+	batches := make(chan batch)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range batches {
+				if opts.Delay > 0 {
+					time.Sleep(opts.Delay)
+				}
+
+				statuses, err := send(b.start, b.end)
+				for i := b.start; i < b.end; i++ {
+					// Each index is only written by the worker owning the batch
+					result.Records[i] = recordResult(i, statuses, i-b.start, err)
+				}
+			}
+		}()
+	}
+
+	for start := 0; start < total; start += size {
+		end := start + size
+		if end > total {
+			end = total
+		}
+		batches <- batch{start, end}
+	}
+	close(batches)
+	wg.Wait()
+
+	return result
+}
+
+// recordResult converts the status at position pos of a batch response into the result of the record at index
+func recordResult(index int, statuses []RecordStatus, pos int, err error) RecordResult {
+	if err != nil {
+		return RecordResult{Index: index, Err: err}
+	}
+	if pos >= len(statuses) {
+		return RecordResult{Index: index, Err: &RecordError{Index: index, Code: "NO_RESPONSE", Message: "no status was returned for this record"}}
+	}
+
+	status := statuses[pos]
+	details := struct {
+		ID               string `json:"id"`
+		APIName          string `json:"api_name"`
+		ExpectedDataType string `json:"expected_data_type"`
+	}{}
+	if len(status.Details) > 0 {
+		// Details is not always an object, the id is best effort
+		_ = json.Unmarshal(status.Details, &details)
+	}
+
+	if status.Status != "success" {
+		return RecordResult{Index: index, Err: &RecordError{
+			Index:            index,
+			Code:             status.Code,
+			Message:          status.Message,
+			APIName:          details.APIName,
+			ExpectedDataType: details.ExpectedDataType,
+		}}
+	}
+
+	return RecordResult{Index: index, ID: details.ID, Action: status.Action}
+}
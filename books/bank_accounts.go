@@ -1 +1,268 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListBankAccounts will return a page of the bank accounts of the organization, filtered and paged with params
+// (eg. 'filter_by', 'sort_column', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/bank-accounts/#list-view-of-accounts
+func (c *API) ListBankAccounts(params map[string]zoho.Parameter) (data BankAccountsResponse, err error) {
+	endpoint := c.ListEndpoint(BankAccountsModule, c.URL(BankAccountsModule), &BankAccountsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list bank accounts"); err != nil {
+		return BankAccountsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankAccountsResponse); ok {
+		return *v, nil
+	}
+
+	return BankAccountsResponse{}, fmt.Errorf("Data retrieved was not 'BankAccountsResponse'")
+}
+
+// GetBankAccount will return the bank account specified by id
+// https://www.zoho.com/books/api/v3/bank-accounts/#get-account-details
+func (c *API) GetBankAccount(id string) (data BankAccountResponse, err error) {
+	endpoint := c.NewEndpoint(BankAccountsModule, zoho.HTTPGet, c.URL("%s/%s", BankAccountsModule, id), &BankAccountResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve bank account (%s)", id)); err != nil {
+		return BankAccountResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankAccountResponse); ok {
+		return *v, nil
+	}
+
+	return BankAccountResponse{}, fmt.Errorf("Data retrieved was not 'BankAccountResponse'")
+}
+
+// CreateBankAccount will create the bank account in request, AccountName and AccountType are required
+// https://www.zoho.com/books/api/v3/bank-accounts/#create-a-bank-account
+func (c *API) CreateBankAccount(request BankAccountRequest) (data BankAccountResponse, err error) {
+	endpoint := c.NewEndpoint(BankAccountsModule, zoho.HTTPPost, c.URL(BankAccountsModule), &BankAccountResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create bank account"); err != nil {
+		return BankAccountResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankAccountResponse); ok {
+		return *v, nil
+	}
+
+	return BankAccountResponse{}, fmt.Errorf("Data retrieved was not 'BankAccountResponse'")
+}
+
+// UpdateBankAccount will update the bank account specified by id with request
+// https://www.zoho.com/books/api/v3/bank-accounts/#update-bank-account
+func (c *API) UpdateBankAccount(request BankAccountRequest, id string) (data BankAccountResponse, err error) {
+	endpoint := c.NewEndpoint(BankAccountsModule, zoho.HTTPPut, c.URL("%s/%s", BankAccountsModule, id), &BankAccountResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update bank account (%s)", id)); err != nil {
+		return BankAccountResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankAccountResponse); ok {
+		return *v, nil
+	}
+
+	return BankAccountResponse{}, fmt.Errorf("Data retrieved was not 'BankAccountResponse'")
+}
+
+// DeleteBankAccount will delete the bank account specified by id
+// https://www.zoho.com/books/api/v3/bank-accounts/#delete-an-account
+func (c *API) DeleteBankAccount(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankAccountsModule, zoho.HTTPDelete, c.URL("%s/%s", BankAccountsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete bank account (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkBankAccountActive will mark the bank account specified by id as active
+// https://www.zoho.com/books/api/v3/bank-accounts/#activate-account
+func (c *API) MarkBankAccountActive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankAccountsModule, zoho.HTTPPost, c.URL("%s/%s/active", BankAccountsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark bank account (%s) as active", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkBankAccountInactive will mark the bank account specified by id as inactive
+// https://www.zoho.com/books/api/v3/bank-accounts/#deactivate-account
+func (c *API) MarkBankAccountInactive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankAccountsModule, zoho.HTTPPost, c.URL("%s/%s/inactive", BankAccountsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark bank account (%s) as inactive", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// GetLastImportedStatement will return the last statement imported to the bank account specified by id
+// https://www.zoho.com/books/api/v3/bank-accounts/#get-last-imported-statement
+func (c *API) GetLastImportedStatement(id string) (data LastImportedStatementResponse, err error) {
+	endpoint := c.NewEndpoint(BankAccountsModule, zoho.HTTPGet, c.URL("%s/%s/statement/lastimported", BankAccountsModule, id), &LastImportedStatementResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve last imported statement of bank account (%s)", id)); err != nil {
+		return LastImportedStatementResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*LastImportedStatementResponse); ok {
+		return *v, nil
+	}
+
+	return LastImportedStatementResponse{}, fmt.Errorf("Data retrieved was not 'LastImportedStatementResponse'")
+}
+
+// DeleteLastImportedStatement will delete the statement statementID, the last one imported to the bank account specified by id
+// https://www.zoho.com/books/api/v3/bank-accounts/#delete-last-imported-statement
+func (c *API) DeleteLastImportedStatement(id string, statementID string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankAccountsModule, zoho.HTTPDelete, c.URL("%s/%s/statement/%s", BankAccountsModule, id, statementID), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete statement (%s) of bank account (%s)", statementID, id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ImportBankStatement will import the transactions of request to the bank account request.AccountID,
+// ParseOFXStatement and ParseCSVStatement build request from a statement file
+// https://www.zoho.com/books/api/v3/bank-transactions/#import-a-bank-credit-card-statement
+func (c *API) ImportBankStatement(request BankStatementRequest) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankStatementsModule, zoho.HTTPPost, c.URL(BankStatementsModule), &Response{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to import statement to bank account (%s)", request.AccountID)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// BankAccountType is the type of a bank account
+type BankAccountType string
+
+// The types of bank accounts
+const (
+	BankAccount       BankAccountType = "bank"
+	CreditCardAccount BankAccountType = "credit_card"
+)
+
+// BankAccountRequest is the data provided to CreateBankAccount and UpdateBankAccount
+type BankAccountRequest struct {
+	AccountName        string          `json:"account_name,omitempty"`
+	AccountType        BankAccountType `json:"account_type,omitempty"`
+	AccountNumber      string          `json:"account_number,omitempty"`
+	AccountCode        string          `json:"account_code,omitempty"`
+	CurrencyID         string          `json:"currency_id,omitempty"`
+	Description        string          `json:"description,omitempty"`
+	BankName           string          `json:"bank_name,omitempty"`
+	RoutingNumber      string          `json:"routing_number,omitempty"`
+	IsPrimaryAccount   bool            `json:"is_primary_account,omitempty"`
+	IsPaypalAccount    bool            `json:"is_paypal_account,omitempty"`
+	PaypalType         string          `json:"paypal_type,omitempty"`
+	PaypalEmailAddress string          `json:"paypal_email_address,omitempty"`
+}
+
+// BankAccountDetails is a bank or credit card account of the organization
+type BankAccountDetails struct {
+	AccountID                 string          `json:"account_id,omitempty"`
+	AccountName               string          `json:"account_name,omitempty"`
+	AccountType               BankAccountType `json:"account_type,omitempty"`
+	AccountNumber             string          `json:"account_number,omitempty"`
+	AccountCode               string          `json:"account_code,omitempty"`
+	IsActive                  bool            `json:"is_active,omitempty"`
+	CurrencyID                string          `json:"currency_id,omitempty"`
+	CurrencyCode              string          `json:"currency_code,omitempty"`
+	Description               string          `json:"description,omitempty"`
+	BankName                  string          `json:"bank_name,omitempty"`
+	RoutingNumber             string          `json:"routing_number,omitempty"`
+	IsPrimaryAccount          bool            `json:"is_primary_account,omitempty"`
+	IsPaypalAccount           bool            `json:"is_paypal_account,omitempty"`
+	UncategorizedTransactions int             `json:"uncategorized_transactions,omitempty"`
+	Balance                   float64         `json:"balance,omitempty"`
+	BankBalance               float64         `json:"bank_balance,omitempty"`
+	BCYBalance                float64         `json:"bcy_balance,omitempty"`
+}
+
+// BankAccountsResponse is the data returned by ListBankAccounts
+type BankAccountsResponse struct {
+	Response
+	BankAccounts []BankAccountDetails `json:"bankaccounts,omitempty"`
+	PageContext  PageContext          `json:"page_context,omitempty"`
+}
+
+// BankAccountResponse is the data returned by GetBankAccount, CreateBankAccount and UpdateBankAccount
+type BankAccountResponse struct {
+	Response
+	BankAccount BankAccountDetails `json:"bankaccount,omitempty"`
+}
+
+// LastImportedStatementResponse is the data returned by GetLastImportedStatement
+type LastImportedStatementResponse struct {
+	Response
+	Statement struct {
+		StatementID  string                 `json:"statement_id,omitempty"`
+		FromDate     string                 `json:"from_date,omitempty"`
+		ToDate       string                 `json:"to_date,omitempty"`
+		Source       string                 `json:"source,omitempty"`
+		Transactions []StatementTransaction `json:"transactions,omitempty"`
+	} `json:"statement,omitempty"`
+}
+
+// BankStatementRequest is the data provided to ImportBankStatement, dates are formatted as yyyy-mm-dd
+type BankStatementRequest struct {
+	AccountID    string                 `json:"account_id"`
+	StartDate    string                 `json:"start_date,omitempty"`
+	EndDate      string                 `json:"end_date,omitempty"`
+	Transactions []StatementTransaction `json:"transactions"`
+}
+
+// DebitOrCredit is the direction of a statement transaction, debits are withdrawals from the account
+type DebitOrCredit string
+
+// The directions of statement transactions
+const (
+	Debit  DebitOrCredit = "debit"
+	Credit DebitOrCredit = "credit"
+)
+
+// StatementTransaction is a line of a bank statement, Amount is always positive
+type StatementTransaction struct {
+	TransactionID   string        `json:"transaction_id,omitempty"`
+	TransactionDate string        `json:"transaction_date,omitempty"`
+	DebitOrCredit   DebitOrCredit `json:"debit_or_credit,omitempty"`
+	Amount          float64       `json:"amount"`
+	Payee           string        `json:"payee,omitempty"`
+	Description     string        `json:"description,omitempty"`
+	ReferenceNumber string        `json:"reference_number,omitempty"`
+}
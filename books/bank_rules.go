@@ -1 +1,145 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListBankRules will return the rules of the bank account accountID
+// https://www.zoho.com/books/api/v3/bank-rules/#get-rules-list
+func (c *API) ListBankRules(accountID string) (data BankRulesResponse, err error) {
+	endpoint := c.NewEndpoint(BankRulesModule, zoho.HTTPGet, c.URL(BankRulesModule), &BankRulesResponse{}, nil)
+	endpoint.URLParameters["account_id"] = zoho.Parameter(accountID)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list bank rules of bank account (%s)", accountID)); err != nil {
+		return BankRulesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankRulesResponse); ok {
+		return *v, nil
+	}
+
+	return BankRulesResponse{}, fmt.Errorf("Data retrieved was not 'BankRulesResponse'")
+}
+
+// GetBankRule will return the bank rule specified by id
+// https://www.zoho.com/books/api/v3/bank-rules/#get-a-rule
+func (c *API) GetBankRule(id string) (data BankRuleResponse, err error) {
+	endpoint := c.NewEndpoint(BankRulesModule, zoho.HTTPGet, c.URL("%s/%s", BankRulesModule, id), &BankRuleResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve bank rule (%s)", id)); err != nil {
+		return BankRuleResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankRuleResponse); ok {
+		return *v, nil
+	}
+
+	return BankRuleResponse{}, fmt.Errorf("Data retrieved was not 'BankRuleResponse'")
+}
+
+// CreateBankRule will create the bank rule in request, RuleName, TargetAccountID, ApplyTo, CriteriaType, Criterion and RecordAs are required
+// https://www.zoho.com/books/api/v3/bank-rules/#create-a-rule
+func (c *API) CreateBankRule(request BankRuleRequest) (data BankRuleResponse, err error) {
+	endpoint := c.NewEndpoint(BankRulesModule, zoho.HTTPPost, c.URL(BankRulesModule), &BankRuleResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create bank rule"); err != nil {
+		return BankRuleResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankRuleResponse); ok {
+		return *v, nil
+	}
+
+	return BankRuleResponse{}, fmt.Errorf("Data retrieved was not 'BankRuleResponse'")
+}
+
+// UpdateBankRule will update the bank rule specified by id with request
+// https://www.zoho.com/books/api/v3/bank-rules/#update-a-rule
+func (c *API) UpdateBankRule(request BankRuleRequest, id string) (data BankRuleResponse, err error) {
+	endpoint := c.NewEndpoint(BankRulesModule, zoho.HTTPPut, c.URL("%s/%s", BankRulesModule, id), &BankRuleResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update bank rule (%s)", id)); err != nil {
+		return BankRuleResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankRuleResponse); ok {
+		return *v, nil
+	}
+
+	return BankRuleResponse{}, fmt.Errorf("Data retrieved was not 'BankRuleResponse'")
+}
+
+// DeleteBankRule will delete the bank rule specified by id
+// https://www.zoho.com/books/api/v3/bank-rules/#delete-a-rule
+func (c *API) DeleteBankRule(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankRulesModule, zoho.HTTPDelete, c.URL("%s/%s", BankRulesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete bank rule (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// BankRuleRequest is the data provided to CreateBankRule and UpdateBankRule.
+// ApplyTo is "deposits" or "withdrawals", CriteriaType is "and" or "or" and RecordAs is eg. "expense", "deposit",
+// "transfer_fund", "vendor_payment" or "customer_payment"
+type BankRuleRequest struct {
+	RuleName        string              `json:"rule_name,omitempty"`
+	TargetAccountID string              `json:"target_account_id,omitempty"`
+	ApplyTo         string              `json:"apply_to,omitempty"`
+	CriteriaType    string              `json:"criteria_type,omitempty"`
+	Criterion       []BankRuleCriterion `json:"criterion,omitempty"`
+	RecordAs        string              `json:"record_as,omitempty"`
+	// AccountID is the account the transactions are categorized to
+	AccountID       string `json:"account_id,omitempty"`
+	CustomerID      string `json:"customer_id,omitempty"`
+	TaxID           string `json:"tax_id,omitempty"`
+	ReferenceNumber string `json:"reference_number,omitempty"`
+	VATTreatment    string `json:"vat_treatment,omitempty"`
+}
+
+// BankRuleCriterion is a condition of a bank rule. Field is eg. "payee", "description", "reference_number" or "amount",
+// Comparator is eg. "is", "is_not", "contains", "starts_with", "greater_than" or "less_than"
+type BankRuleCriterion struct {
+	CriteriaID string      `json:"criteria_id,omitempty"`
+	Field      string      `json:"field"`
+	Comparator string      `json:"comparator"`
+	Value      interface{} `json:"value"`
+}
+
+// BankRule categorizes the transactions of a bank account matching its criteria
+type BankRule struct {
+	RuleID          string              `json:"rule_id,omitempty"`
+	RuleName        string              `json:"rule_name,omitempty"`
+	RuleOrder       int                 `json:"rule_order,omitempty"`
+	ApplyTo         string              `json:"apply_to,omitempty"`
+	CriteriaType    string              `json:"criteria_type,omitempty"`
+	Criterion       []BankRuleCriterion `json:"criterion,omitempty"`
+	RecordAs        string              `json:"record_as,omitempty"`
+	AccountID       string              `json:"account_id,omitempty"`
+	AccountName     string              `json:"account_name,omitempty"`
+	TargetAccountID string              `json:"target_account_id,omitempty"`
+	CustomerID      string              `json:"customer_id,omitempty"`
+	CustomerName    string              `json:"customer_name,omitempty"`
+	TaxID           string              `json:"tax_id,omitempty"`
+	ReferenceNumber string              `json:"reference_number,omitempty"`
+}
+
+// BankRulesResponse is the data returned by ListBankRules
+type BankRulesResponse struct {
+	Response
+	Rules []BankRule `json:"rules,omitempty"`
+}
+
+// BankRuleResponse is the data returned by GetBankRule, CreateBankRule and UpdateBankRule
+type BankRuleResponse struct {
+	Response
+	Rule BankRule `json:"rule,omitempty"`
+}
@@ -1 +1,328 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListBankTransactions will return a page of the transactions of the bank accounts, filtered and paged with params
+// (eg. 'account_id', 'transaction_type', 'date', 'status', 'filter_by', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/bank-transactions/#get-transactions-list
+func (c *API) ListBankTransactions(params map[string]zoho.Parameter) (data BankTransactionsResponse, err error) {
+	endpoint := c.ListEndpoint(BankTransactionsModule, c.URL(BankTransactionsModule), &BankTransactionsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list bank transactions"); err != nil {
+		return BankTransactionsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankTransactionsResponse); ok {
+		return *v, nil
+	}
+
+	return BankTransactionsResponse{}, fmt.Errorf("Data retrieved was not 'BankTransactionsResponse'")
+}
+
+// ListUncategorizedTransactions will return a page of the uncategorized transactions of the bank account accountID,
+// the transactions to be categorized or matched
+// https://www.zoho.com/books/api/v3/bank-transactions/#get-transactions-list
+func (c *API) ListUncategorizedTransactions(accountID string, params map[string]zoho.Parameter) (data BankTransactionsResponse, err error) {
+	p := map[string]zoho.Parameter{
+		"account_id": zoho.Parameter(accountID),
+		"filter_by":  "Status.Uncategorized",
+	}
+	for k, v := range params {
+		p[k] = v
+	}
+	return c.ListBankTransactions(p)
+}
+
+// GetBankTransaction will return the bank transaction specified by id
+// https://www.zoho.com/books/api/v3/bank-transactions/#get-transaction
+func (c *API) GetBankTransaction(id string) (data BankTransactionResponse, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPGet, c.URL("%s/%s", BankTransactionsModule, id), &BankTransactionResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve bank transaction (%s)", id)); err != nil {
+		return BankTransactionResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankTransactionResponse); ok {
+		return *v, nil
+	}
+
+	return BankTransactionResponse{}, fmt.Errorf("Data retrieved was not 'BankTransactionResponse'")
+}
+
+// CreateBankTransaction will create the bank transaction in request, such as a transfer between accounts or a deposit
+// https://www.zoho.com/books/api/v3/bank-transactions/#create-a-transaction-for-an-account
+func (c *API) CreateBankTransaction(request BankTransactionRequest) (data BankTransactionResponse, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPPost, c.URL(BankTransactionsModule), &BankTransactionResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create bank transaction"); err != nil {
+		return BankTransactionResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BankTransactionResponse); ok {
+		return *v, nil
+	}
+
+	return BankTransactionResponse{}, fmt.Errorf("Data retrieved was not 'BankTransactionResponse'")
+}
+
+// DeleteBankTransaction will delete the bank transaction specified by id
+// https://www.zoho.com/books/api/v3/bank-transactions/#delete-a-transaction
+func (c *API) DeleteBankTransaction(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPDelete, c.URL("%s/%s", BankTransactionsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete bank transaction (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// GetMatchingTransactions will return the transactions of the organization (invoices, bills, payments...) suggested
+// as matches of the uncategorized transaction specified by id, filtered with params (eg. 'amount_start', 'amount_end', 'date_after', 'contact')
+// https://www.zoho.com/books/api/v3/bank-transactions/#get-matching-transactions
+func (c *API) GetMatchingTransactions(id string, params map[string]zoho.Parameter) (data MatchingTransactionsResponse, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPGet, c.URL("%s/uncategorized/%s/match", BankTransactionsModule, id), &MatchingTransactionsResponse{}, nil)
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve matching transactions of bank transaction (%s)", id)); err != nil {
+		return MatchingTransactionsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*MatchingTransactionsResponse); ok {
+		return *v, nil
+	}
+
+	return MatchingTransactionsResponse{}, fmt.Errorf("Data retrieved was not 'MatchingTransactionsResponse'")
+}
+
+// MatchTransaction will match the uncategorized transaction specified by id with the transactions of request,
+// their total must equal the amount of the bank transaction
+// https://www.zoho.com/books/api/v3/bank-transactions/#match-a-transaction
+func (c *API) MatchTransaction(request MatchTransactionRequest, id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPPost, c.URL("%s/uncategorized/%s/match", BankTransactionsModule, id), &Response{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to match bank transaction (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// UnmatchTransaction will unmatch the matched transaction specified by id, which becomes uncategorized again
+// https://www.zoho.com/books/api/v3/bank-transactions/#unmatch-a-matched-transaction
+func (c *API) UnmatchTransaction(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPPost, c.URL("%s/%s/unmatch", BankTransactionsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to unmatch bank transaction (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ExcludeTransaction will exclude the uncategorized transaction specified by id, eg. a duplicate of the statement
+// https://www.zoho.com/books/api/v3/bank-transactions/#exclude-a-transaction
+func (c *API) ExcludeTransaction(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPPost, c.URL("%s/uncategorized/%s/exclude", BankTransactionsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to exclude bank transaction (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// RestoreTransaction will restore the excluded transaction specified by id
+// https://www.zoho.com/books/api/v3/bank-transactions/#restore-a-transaction
+func (c *API) RestoreTransaction(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPPost, c.URL("%s/uncategorized/%s/restore", BankTransactionsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to restore bank transaction (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// CategorizeTransaction will categorize the uncategorized transaction specified by id as described by request
+// https://www.zoho.com/books/api/v3/bank-transactions/#categorize-an-uncategorized-transaction
+func (c *API) CategorizeTransaction(request BankTransactionRequest, id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPPost, c.URL("%s/uncategorized/%s/categorize", BankTransactionsModule, id), &Response{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to categorize bank transaction (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// CategorizeTransactionAs will categorize the uncategorized transaction specified by id as a transaction of type as,
+// created from request (eg. a VendorPaymentRequest for CategorizeAsVendorPayment or a CustomerPaymentRequest for CategorizeAsCustomerPayment)
+// https://www.zoho.com/books/api/v3/bank-transactions/#categorize-as-expense
+func (c *API) CategorizeTransactionAs(request interface{}, id string, as CategorizeAs) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPPost, c.URL("%s/uncategorized/%s/categorize/%s", BankTransactionsModule, id, as), &Response{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to categorize bank transaction (%s) as %s", id, as)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// UncategorizeTransaction will uncategorize the categorized transaction specified by id
+// https://www.zoho.com/books/api/v3/bank-transactions/#uncategorize-a-categorized-transaction
+func (c *API) UncategorizeTransaction(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BankTransactionsModule, zoho.HTTPPost, c.URL("%s/%s/uncategorize", BankTransactionsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to uncategorize bank transaction (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// CategorizeAs is the type of transaction an uncategorized bank transaction is categorized as by CategorizeTransactionAs
+type CategorizeAs string
+
+// The types of transactions a bank transaction is categorized as
+const (
+	CategorizeAsExpense            CategorizeAs = "expenses"
+	CategorizeAsVendorPayment      CategorizeAs = "vendorpayments"
+	CategorizeAsCustomerPayment    CategorizeAs = "customerpayments"
+	CategorizeAsCreditNoteRefund   CategorizeAs = "creditnoterefunds"
+	CategorizeAsVendorCreditRefund CategorizeAs = "vendorcreditrefunds"
+	CategorizeAsPaymentRefund      CategorizeAs = "paymentrefunds"
+)
+
+// BankTransactionRequest is the data provided to CreateBankTransaction and CategorizeTransaction. TransactionType is eg.
+// "deposit", "expense", "transfer_fund", "card_payment", "owner_contribution", "owner_drawings" or "other_income"
+type BankTransactionRequest struct {
+	FromAccountID   string  `json:"from_account_id,omitempty"`
+	ToAccountID     string  `json:"to_account_id,omitempty"`
+	TransactionType string  `json:"transaction_type,omitempty"`
+	Amount          float64 `json:"amount,omitempty"`
+	PaymentMode     string  `json:"payment_mode,omitempty"`
+	ExchangeRate    float64 `json:"exchange_rate,omitempty"`
+	Date            string  `json:"date,omitempty"`
+	CustomerID      string  `json:"customer_id,omitempty"`
+	ReferenceNumber string  `json:"reference_number,omitempty"`
+	Description     string  `json:"description,omitempty"`
+	CurrencyID      string  `json:"currency_id,omitempty"`
+	TaxID           string  `json:"tax_id,omitempty"`
+	IsInclusiveTax  bool    `json:"is_inclusive_tax,omitempty"`
+}
+
+// BankTransaction is a transaction of a bank account
+type BankTransaction struct {
+	TransactionID         string        `json:"transaction_id,omitempty"`
+	ImportedTransactionID string        `json:"imported_transaction_id,omitempty"`
+	AccountID             string        `json:"account_id,omitempty"`
+	AccountName           string        `json:"account_name,omitempty"`
+	TransactionType       string        `json:"transaction_type,omitempty"`
+	Status                string        `json:"status,omitempty"`
+	DebitOrCredit         DebitOrCredit `json:"debit_or_credit,omitempty"`
+	Date                  string        `json:"date,omitempty"`
+	Amount                float64       `json:"amount,omitempty"`
+	Payee                 string        `json:"payee,omitempty"`
+	Description           string        `json:"description,omitempty"`
+	ReferenceNumber       string        `json:"reference_number,omitempty"`
+	CustomerID            string        `json:"customer_id,omitempty"`
+	CustomerName          string        `json:"customer_name,omitempty"`
+	CurrencyID            string        `json:"currency_id,omitempty"`
+	CurrencyCode          string        `json:"currency_code,omitempty"`
+	OffsetAccountName     string        `json:"offset_account_name,omitempty"`
+	Source                string        `json:"source,omitempty"`
+	IsPaymentClearing     bool          `json:"is_paymentclearing,omitempty"`
+}
+
+// BankTransactionsResponse is the data returned by ListBankTransactions and ListUncategorizedTransactions
+type BankTransactionsResponse struct {
+	Response
+	BankTransactions []BankTransaction `json:"banktransactions,omitempty"`
+	PageContext      PageContext       `json:"page_context,omitempty"`
+}
+
+// BankTransactionResponse is the data returned by GetBankTransaction and CreateBankTransaction
+type BankTransactionResponse struct {
+	Response
+	BankTransaction BankTransaction `json:"banktransaction,omitempty"`
+}
+
+// MatchingTransaction is a transaction suggested as a match of an uncategorized bank transaction
+type MatchingTransaction struct {
+	TransactionID     string        `json:"transaction_id,omitempty"`
+	TransactionType   string        `json:"transaction_type,omitempty"`
+	TransactionNumber string        `json:"transaction_number,omitempty"`
+	Date              string        `json:"date,omitempty"`
+	ContactName       string        `json:"contact_name,omitempty"`
+	ReferenceNumber   string        `json:"reference_number,omitempty"`
+	DebitOrCredit     DebitOrCredit `json:"debit_or_credit,omitempty"`
+	Amount            float64       `json:"amount,omitempty"`
+	IsBestMatch       bool          `json:"is_best_match,omitempty"`
+}
+
+// MatchingTransactionsResponse is the data returned by GetMatchingTransactions
+type MatchingTransactionsResponse struct {
+	Response
+	MatchingTransactions []MatchingTransaction `json:"matching_transactions,omitempty"`
+	PageContext          PageContext           `json:"page_context,omitempty"`
+}
+
+// BestMatch returns the suggestion Books considers the best match, if any
+func (r MatchingTransactionsResponse) BestMatch() (MatchingTransaction, bool) {
+	for _, t := range r.MatchingTransactions {
+		if t.IsBestMatch {
+			return t, true
+		}
+	}
+	return MatchingTransaction{}, false
+}
+
+// MatchTransactionRequest is the data provided to MatchTransaction
+type MatchTransactionRequest struct {
+	TransactionsToBeMatched []TransactionToMatch `json:"transactions_to_be_matched"`
+}
+
+// TransactionToMatch is a transaction matched with a bank transaction, TransactionType is as returned by GetMatchingTransactions
+type TransactionToMatch struct {
+	TransactionID   string `json:"transaction_id"`
+	TransactionType string `json:"transaction_type"`
+}
@@ -1 +1,159 @@
 package books
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListBaseCurrencyAdjustments will return a page of the base currency adjustments of the organization, filtered and paged with params
+// (eg. 'filter_by', 'sort_column', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/base-currency-adjustment/#list-base-currency-adjustment
+func (c *API) ListBaseCurrencyAdjustments(params map[string]zoho.Parameter) (data BaseCurrencyAdjustmentsResponse, err error) {
+	endpoint := c.ListEndpoint(BaseCurrencyAdjustmentsModule, c.URL(BaseCurrencyAdjustmentsModule), &BaseCurrencyAdjustmentsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list base currency adjustments"); err != nil {
+		return BaseCurrencyAdjustmentsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BaseCurrencyAdjustmentsResponse); ok {
+		return *v, nil
+	}
+
+	return BaseCurrencyAdjustmentsResponse{}, fmt.Errorf("Data retrieved was not 'BaseCurrencyAdjustmentsResponse'")
+}
+
+// GetBaseCurrencyAdjustment will return the base currency adjustment specified by id
+// https://www.zoho.com/books/api/v3/base-currency-adjustment/#get-base-currency-adjustment
+func (c *API) GetBaseCurrencyAdjustment(id string) (data BaseCurrencyAdjustmentResponse, err error) {
+	endpoint := c.NewEndpoint(BaseCurrencyAdjustmentsModule, zoho.HTTPGet, c.URL("%s/%s", BaseCurrencyAdjustmentsModule, id), &BaseCurrencyAdjustmentResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve base currency adjustment (%s)", id)); err != nil {
+		return BaseCurrencyAdjustmentResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BaseCurrencyAdjustmentResponse); ok {
+		return *v, nil
+	}
+
+	return BaseCurrencyAdjustmentResponse{}, fmt.Errorf("Data retrieved was not 'BaseCurrencyAdjustmentResponse'")
+}
+
+// ListBaseCurrencyAdjustmentAccounts will return the accounts holding transactions in the currency of request,
+// with the gain or loss the adjustment in request would record for each of them
+// https://www.zoho.com/books/api/v3/base-currency-adjustment/#list-account-details-for-base-currency-adjustment
+func (c *API) ListBaseCurrencyAdjustmentAccounts(request BaseCurrencyAdjustmentRequest) (data BaseCurrencyAdjustmentAccountsResponse, err error) {
+	endpoint := c.NewEndpoint(BaseCurrencyAdjustmentsModule, zoho.HTTPGet, c.URL("%s/accounts", BaseCurrencyAdjustmentsModule), &BaseCurrencyAdjustmentAccountsResponse{}, nil)
+	endpoint.URLParameters = request.parameters()
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list base currency adjustment accounts of currency (%s)", request.CurrencyID)); err != nil {
+		return BaseCurrencyAdjustmentAccountsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BaseCurrencyAdjustmentAccountsResponse); ok {
+		return *v, nil
+	}
+
+	return BaseCurrencyAdjustmentAccountsResponse{}, fmt.Errorf("Data retrieved was not 'BaseCurrencyAdjustmentAccountsResponse'")
+}
+
+// CreateBaseCurrencyAdjustment will create the base currency adjustment in request for the accounts accountIDs,
+// as returned by ListBaseCurrencyAdjustmentAccounts. CurrencyID, AdjustmentDate, ExchangeRate and Notes are required.
+// https://www.zoho.com/books/api/v3/base-currency-adjustment/#create-a-base-currency-adjustment
+func (c *API) CreateBaseCurrencyAdjustment(request BaseCurrencyAdjustmentRequest, accountIDs ...string) (data BaseCurrencyAdjustmentResponse, err error) {
+	if len(accountIDs) == 0 {
+		return BaseCurrencyAdjustmentResponse{}, fmt.Errorf("Failed to create base currency adjustment, must provide at least 1 account ID")
+	}
+
+	endpoint := c.NewEndpoint(BaseCurrencyAdjustmentsModule, zoho.HTTPPost, c.URL(BaseCurrencyAdjustmentsModule), &BaseCurrencyAdjustmentResponse{}, request)
+	endpoint.URLParameters["account_ids"] = zoho.Parameter(strings.Join(accountIDs, ","))
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to create base currency adjustment of currency (%s)", request.CurrencyID)); err != nil {
+		return BaseCurrencyAdjustmentResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BaseCurrencyAdjustmentResponse); ok {
+		return *v, nil
+	}
+
+	return BaseCurrencyAdjustmentResponse{}, fmt.Errorf("Data retrieved was not 'BaseCurrencyAdjustmentResponse'")
+}
+
+// DeleteBaseCurrencyAdjustment will delete the base currency adjustment specified by id
+// https://www.zoho.com/books/api/v3/base-currency-adjustment/#delete-a-base-currency-adjustment
+func (c *API) DeleteBaseCurrencyAdjustment(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BaseCurrencyAdjustmentsModule, zoho.HTTPDelete, c.URL("%s/%s", BaseCurrencyAdjustmentsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete base currency adjustment (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// BaseCurrencyAdjustmentRequest is the data provided to ListBaseCurrencyAdjustmentAccounts and CreateBaseCurrencyAdjustment,
+// ExchangeRate is the rate of the currency at AdjustmentDate
+type BaseCurrencyAdjustmentRequest struct {
+	CurrencyID     string  `json:"currency_id,omitempty"`
+	AdjustmentDate string  `json:"adjustment_date,omitempty"`
+	ExchangeRate   float64 `json:"exchange_rate,omitempty"`
+	Notes          string  `json:"notes,omitempty"`
+}
+
+// parameters returns request as the parameters of ListBaseCurrencyAdjustmentAccounts
+func (r BaseCurrencyAdjustmentRequest) parameters() map[string]zoho.Parameter {
+	return map[string]zoho.Parameter{
+		"currency_id":     zoho.Parameter(r.CurrencyID),
+		"adjustment_date": zoho.Parameter(r.AdjustmentDate),
+		"exchange_rate":   zoho.Parameter(strconv.FormatFloat(r.ExchangeRate, 'f', -1, 64)),
+		"notes":           zoho.Parameter(r.Notes),
+	}
+}
+
+// BaseCurrencyAdjustmentAccount is an account adjusted by a base currency adjustment
+type BaseCurrencyAdjustmentAccount struct {
+	AccountID       string  `json:"account_id,omitempty"`
+	AccountName     string  `json:"account_name,omitempty"`
+	BCYBalance      float64 `json:"bcy_balance,omitempty"`
+	FCYBalance      float64 `json:"fcy_balance,omitempty"`
+	AdjustedBalance float64 `json:"adjusted_balance,omitempty"`
+	GainOrLoss      float64 `json:"gain_or_loss,omitempty"`
+	GLSpecificType  int     `json:"gl_specific_type,omitempty"`
+}
+
+// BaseCurrencyAdjustment is a revaluation of the accounts holding a foreign currency at a new exchange rate
+type BaseCurrencyAdjustment struct {
+	BaseCurrencyAdjustmentID string                          `json:"base_currency_adjustment_id,omitempty"`
+	AdjustmentDate           string                          `json:"adjustment_date,omitempty"`
+	CurrencyID               string                          `json:"currency_id,omitempty"`
+	CurrencyCode             string                          `json:"currency_code,omitempty"`
+	ExchangeRate             float64                         `json:"exchange_rate,omitempty"`
+	Notes                    string                          `json:"notes,omitempty"`
+	GainOrLoss               float64                         `json:"gain_or_loss,omitempty"`
+	Accounts                 []BaseCurrencyAdjustmentAccount `json:"accounts,omitempty"`
+}
+
+// BaseCurrencyAdjustmentsResponse is the data returned by ListBaseCurrencyAdjustments
+type BaseCurrencyAdjustmentsResponse struct {
+	Response
+	BaseCurrencyAdjustments []BaseCurrencyAdjustment `json:"base_currency_adjustments,omitempty"`
+	PageContext             PageContext              `json:"page_context,omitempty"`
+}
+
+// BaseCurrencyAdjustmentResponse is the data returned by GetBaseCurrencyAdjustment and CreateBaseCurrencyAdjustment
+type BaseCurrencyAdjustmentResponse struct {
+	Response
+	Data BaseCurrencyAdjustment `json:"data,omitempty"`
+}
+
+// BaseCurrencyAdjustmentAccountsResponse is the data returned by ListBaseCurrencyAdjustmentAccounts
+type BaseCurrencyAdjustmentAccountsResponse struct {
+	Response
+	Data BaseCurrencyAdjustment `json:"data,omitempty"`
+}
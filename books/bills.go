@@ -1 +1,346 @@
 package books
+
+import (
+	"fmt"
+	"io"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListBills will return a page of the bills of the organization, filtered and paged with params
+// (eg. 'vendor_id', 'status', 'date_start', 'date_end', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/bills/#list-bills
+func (c *API) ListBills(params map[string]zoho.Parameter) (data BillsResponse, err error) {
+	endpoint := c.ListEndpoint(BillsModule, c.URL(BillsModule), &BillsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list bills"); err != nil {
+		return BillsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BillsResponse); ok {
+		return *v, nil
+	}
+
+	return BillsResponse{}, fmt.Errorf("Data retrieved was not 'BillsResponse'")
+}
+
+// GetBill will return the bill specified by id
+// https://www.zoho.com/books/api/v3/bills/#get-a-bill
+func (c *API) GetBill(id string) (data BillResponse, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPGet, c.URL("%s/%s", BillsModule, id), &BillResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve bill (%s)", id)); err != nil {
+		return BillResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BillResponse); ok {
+		return *v, nil
+	}
+
+	return BillResponse{}, fmt.Errorf("Data retrieved was not 'BillResponse'")
+}
+
+// CreateBill will create the bill in request, VendorID, BillNumber and LineItems are required
+// https://www.zoho.com/books/api/v3/bills/#create-a-bill
+func (c *API) CreateBill(request BillRequest) (data BillResponse, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPPost, c.URL(BillsModule), &BillResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create bill"); err != nil {
+		return BillResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BillResponse); ok {
+		return *v, nil
+	}
+
+	return BillResponse{}, fmt.Errorf("Data retrieved was not 'BillResponse'")
+}
+
+// UpdateBill will update the bill specified by id with request
+// https://www.zoho.com/books/api/v3/bills/#update-a-bill
+func (c *API) UpdateBill(request BillRequest, id string) (data BillResponse, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPPut, c.URL("%s/%s", BillsModule, id), &BillResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update bill (%s)", id)); err != nil {
+		return BillResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BillResponse); ok {
+		return *v, nil
+	}
+
+	return BillResponse{}, fmt.Errorf("Data retrieved was not 'BillResponse'")
+}
+
+// DeleteBill will delete the bill specified by id
+// https://www.zoho.com/books/api/v3/bills/#delete-a-bill
+func (c *API) DeleteBill(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPDelete, c.URL("%s/%s", BillsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete bill (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkBillOpen will mark the draft or void bill specified by id as open
+// https://www.zoho.com/books/api/v3/bills/#mark-a-bill-as-open
+func (c *API) MarkBillOpen(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPPost, c.URL("%s/%s/status/open", BillsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark bill (%s) as open", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// VoidBill will mark the bill specified by id as void
+// https://www.zoho.com/books/api/v3/bills/#void-a-bill
+func (c *API) VoidBill(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPPost, c.URL("%s/%s/status/void", BillsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark bill (%s) as void", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// SubmitBill will submit the bill specified by id for approval
+// https://www.zoho.com/books/api/v3/bills/#submit-a-bill-for-approval
+func (c *API) SubmitBill(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPPost, c.URL("%s/%s/submit", BillsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to submit bill (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ApproveBill will approve the bill specified by id
+// https://www.zoho.com/books/api/v3/bills/#approve-a-bill
+func (c *API) ApproveBill(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPPost, c.URL("%s/%s/approve", BillsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to approve bill (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ApplyCreditsToBill will apply the vendor credits and unused vendor payments of request to the bill specified by id
+// https://www.zoho.com/books/api/v3/bills/#apply-credits
+func (c *API) ApplyCreditsToBill(request BillCreditsRequest, id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPPost, c.URL("%s/%s/credits", BillsModule, id), &Response{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to apply credits to bill (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ListBillPayments will return the payments and credits applied to the bill specified by id
+// https://www.zoho.com/books/api/v3/bills/#list-bill-payments
+func (c *API) ListBillPayments(id string) (data BillPaymentsResponse, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPGet, c.URL("%s/%s/payments", BillsModule, id), &BillPaymentsResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list payments of bill (%s)", id)); err != nil {
+		return BillPaymentsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*BillPaymentsResponse); ok {
+		return *v, nil
+	}
+
+	return BillPaymentsResponse{}, fmt.Errorf("Data retrieved was not 'BillPaymentsResponse'")
+}
+
+// UploadBillAttachment attaches the file at path file to the bill specified by id, a bill holds a single attachment
+// https://www.zoho.com/books/api/v3/bills/#add-attachment-to-a-bill
+func (c *API) UploadBillAttachment(id string, file string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPPost, c.URL("%s/%s/attachment", BillsModule, id), &Response{}, nil)
+	endpoint.BodyFormat = zoho.FILE
+	endpoint.Attachment = file
+
+	return c.sendBillAttachment(&endpoint, fmt.Sprintf("Failed to upload attachment of bill (%s)", id))
+}
+
+// UploadBillAttachmentReader attaches the contents of r to the bill specified by id under the name filename
+// https://www.zoho.com/books/api/v3/bills/#add-attachment-to-a-bill
+func (c *API) UploadBillAttachmentReader(id string, filename string, r io.Reader) (data Response, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPPost, c.URL("%s/%s/attachment", BillsModule, id), &Response{}, nil)
+	endpoint.BodyFormat = zoho.FILE_READER
+	endpoint.Attachment = filename
+	endpoint.AttachmentReader = r
+
+	return c.sendBillAttachment(&endpoint, fmt.Sprintf("Failed to upload attachment of bill (%s)", id))
+}
+
+// DeleteBillAttachment deletes the attachment of the bill specified by id
+// https://www.zoho.com/books/api/v3/bills/#delete-an-attachment
+func (c *API) DeleteBillAttachment(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPDelete, c.URL("%s/%s/attachment", BillsModule, id), &Response{}, nil)
+
+	return c.sendBillAttachment(&endpoint, fmt.Sprintf("Failed to delete attachment of bill (%s)", id))
+}
+
+// DownloadBillAttachment writes the attachment of the bill specified by id to w
+// https://www.zoho.com/books/api/v3/bills/#get-a-bill-attachment
+func (c *API) DownloadBillAttachment(id string, w io.Writer) error {
+	endpoint := c.NewEndpoint(BillsModule, zoho.HTTPGet, c.URL("%s/%s/attachment", BillsModule, id), nil, nil)
+
+	if err := c.Zoho.HTTPDownload(&endpoint, w); err != nil {
+		return fmt.Errorf("Failed to download attachment of bill (%s): %s", id, err)
+	}
+	return nil
+}
+
+// sendBillAttachment performs an attachment request of a bill
+func (c *API) sendBillAttachment(endpoint *zoho.Endpoint, failure string) (Response, error) {
+	if err := c.Send(endpoint, failure); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// BillRequest is the data provided to CreateBill and UpdateBill
+type BillRequest struct {
+	VendorID          string  `json:"vendor_id,omitempty"`
+	BillNumber        string  `json:"bill_number,omitempty"`
+	ReferenceNumber   string  `json:"reference_number,omitempty"`
+	Date              string  `json:"date,omitempty"`
+	DueDate           string  `json:"due_date,omitempty"`
+	PaymentTerms      int     `json:"payment_terms,omitempty"`
+	PaymentTermsLabel string  `json:"payment_terms_label,omitempty"`
+	CurrencyID        string  `json:"currency_id,omitempty"`
+	ExchangeRate      float64 `json:"exchange_rate,omitempty"`
+	IsInclusiveTax    bool    `json:"is_inclusive_tax,omitempty"`
+	// PurchaseOrderIDs are the purchase orders the bill is raised for
+	PurchaseOrderIDs      []string      `json:"purchaseorder_ids,omitempty"`
+	LineItems             []LineItem    `json:"line_items,omitempty"`
+	Adjustment            float64       `json:"adjustment,omitempty"`
+	AdjustmentDescription string        `json:"adjustment_description,omitempty"`
+	Notes                 string        `json:"notes,omitempty"`
+	Terms                 string        `json:"terms,omitempty"`
+	TaxAuthorityID        string        `json:"tax_authority_id,omitempty"`
+	TaxExemptionID        string        `json:"tax_exemption_id,omitempty"`
+	CustomFields          []CustomField `json:"custom_fields,omitempty"`
+}
+
+// Bill is a bill received from a vendor
+type Bill struct {
+	BillID                string        `json:"bill_id,omitempty"`
+	BillNumber            string        `json:"bill_number,omitempty"`
+	Status                string        `json:"status,omitempty"`
+	VendorID              string        `json:"vendor_id,omitempty"`
+	VendorName            string        `json:"vendor_name,omitempty"`
+	ReferenceNumber       string        `json:"reference_number,omitempty"`
+	Date                  string        `json:"date,omitempty"`
+	DueDate               string        `json:"due_date,omitempty"`
+	PaymentTerms          int           `json:"payment_terms,omitempty"`
+	PaymentTermsLabel     string        `json:"payment_terms_label,omitempty"`
+	CurrencyID            string        `json:"currency_id,omitempty"`
+	CurrencyCode          string        `json:"currency_code,omitempty"`
+	ExchangeRate          float64       `json:"exchange_rate,omitempty"`
+	IsInclusiveTax        bool          `json:"is_inclusive_tax,omitempty"`
+	PurchaseOrderIDs      []string      `json:"purchaseorder_ids,omitempty"`
+	LineItems             []LineItem    `json:"line_items,omitempty"`
+	Adjustment            float64       `json:"adjustment,omitempty"`
+	AdjustmentDescription string        `json:"adjustment_description,omitempty"`
+	SubTotal              float64       `json:"sub_total,omitempty"`
+	TaxTotal              float64       `json:"tax_total,omitempty"`
+	Total                 float64       `json:"total,omitempty"`
+	Taxes                 []Tax         `json:"taxes,omitempty"`
+	PaymentMade           float64       `json:"payment_made,omitempty"`
+	VendorCreditsApplied  float64       `json:"vendor_credits_applied,omitempty"`
+	Balance               float64       `json:"balance,omitempty"`
+	BillingAddress        Address       `json:"billing_address,omitempty"`
+	HasAttachment         bool          `json:"has_attachment,omitempty"`
+	AttachmentName        string        `json:"attachment_name,omitempty"`
+	Notes                 string        `json:"notes,omitempty"`
+	Terms                 string        `json:"terms,omitempty"`
+	CustomFields          []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime           string        `json:"created_time,omitempty"`
+	LastModifiedTime      string        `json:"last_modified_time,omitempty"`
+}
+
+// BillsResponse is the data returned by ListBills
+type BillsResponse struct {
+	Response
+	Bills       []Bill      `json:"bills,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// BillResponse is the data returned by GetBill, CreateBill and UpdateBill
+type BillResponse struct {
+	Response
+	Bill Bill `json:"bill,omitempty"`
+}
+
+// BillCreditsRequest is the data provided to ApplyCreditsToBill
+type BillCreditsRequest struct {
+	// BillPayments are unused amounts of vendor payments
+	BillPayments       []AppliedPayment      `json:"bill_payments,omitempty"`
+	ApplyVendorCredits []AppliedVendorCredit `json:"apply_vendor_credits,omitempty"`
+}
+
+// AppliedVendorCredit is the amount of a vendor credit applied to a bill
+type AppliedVendorCredit struct {
+	VendorCreditID string  `json:"vendor_credit_id"`
+	AmountApplied  float64 `json:"amount_applied"`
+}
+
+// BillPaymentsResponse is the data returned by ListBillPayments
+type BillPaymentsResponse struct {
+	Response
+	Payments []struct {
+		PaymentID              string  `json:"payment_id,omitempty"`
+		BillID                 string  `json:"bill_id,omitempty"`
+		BillPaymentID          string  `json:"bill_payment_id,omitempty"`
+		VendorID               string  `json:"vendor_id,omitempty"`
+		VendorName             string  `json:"vendor_name,omitempty"`
+		PaymentMode            string  `json:"payment_mode,omitempty"`
+		Description            string  `json:"description,omitempty"`
+		Date                   string  `json:"date,omitempty"`
+		ReferenceNumber        string  `json:"reference_number,omitempty"`
+		ExchangeRate           float64 `json:"exchange_rate,omitempty"`
+		Amount                 float64 `json:"amount,omitempty"`
+		PaidThroughAccountID   string  `json:"paid_through_account_id,omitempty"`
+		PaidThroughAccountName string  `json:"paid_through_account_name,omitempty"`
+		IsSingleBillPayment    bool    `json:"is_single_bill_payment,omitempty"`
+	} `json:"payments,omitempty"`
+}
@@ -1 +1,144 @@
+// Wrapper for Zoho Books API from https://www.zoho.com/books/api/v3/
+
 package books
+
+import (
+	"math/rand"
+
+	zoho "github.com/iapon/zoho"
+	"github.com/iapon/zoho/finance"
+)
+
+// Change here only if these values changes over time
+const (
+	BooksAPIEndpoint              string = "https://www.zohoapis.%s/books/v3/"
+	BooksAPIEndpointHeader        string = "X-com-zoho-books-organizationid"
+	ContactsModule                       = finance.ContactsModule
+	ContactPersonsModule                 = finance.ContactPersonsModule
+	ItemsModule                          = finance.ItemsModule
+	InvoicesModule                       = finance.InvoicesModule
+	CustomerPaymentsModule               = finance.CustomerPaymentsModule
+	EstimatesModule               string = "estimates"
+	SalesOrdersModule             string = "salesorders"
+	CreditNotesModule             string = "creditnotes"
+	BillsModule                   string = "bills"
+	PurchaseOrdersModule          string = "purchaseorders"
+	VendorPaymentsModule          string = "vendorpayments"
+	VendorCreditsModule           string = "vendorcredits"
+	BankAccountsModule            string = "bankaccounts"
+	BankTransactionsModule        string = "banktransactions"
+	BankStatementsModule          string = "bankstatements"
+	BankRulesModule               string = "bankaccounts/rules"
+	ChartOfAccountsModule         string = "chartofaccounts"
+	JournalsModule                string = "journals"
+	OpeningBalancesModule         string = "settings/openingbalances"
+	CurrenciesModule              string = "settings/currencies"
+	BaseCurrencyAdjustmentsModule string = "basecurrencyadjustment"
+	ProjectsModule                string = "projects"
+	TasksModule                   string = "tasks"
+	TimeEntriesModule             string = "projects/timeentries"
+	UsersModule                   string = "users"
+	RecurringInvoicesModule       string = "recurringinvoices"
+	RecurringExpensesModule       string = "recurringexpenses"
+	RetainerInvoicesModule        string = "retainerinvoices"
+	TaxesModule                   string = "settings/taxes"
+	TaxGroupsModule               string = "settings/taxgroups"
+	TaxAuthoritiesModule          string = "settings/taxauthorities"
+	TaxExemptionsModule           string = "settings/taxexemptions"
+)
+
+// API is used for interacting with the Zoho Books API
+// the exposed methods are primarily access to Books modules which provide access to Books Methods.
+// Contacts, contact persons, items, invoices and customer payments are shared with Zoho Invoice and are
+// promoted from the embedded *finance.Client.
+type API struct {
+	*finance.Client
+	id    string
+	taxes taxCache
+}
+
+// New returns a *books.API with the provided zoho.Zoho as an embedded field
+func New(z *zoho.Zoho) *API {
+	id := func() string {
+		var id []byte
+		keyspace := "abcdefghijklmnopqrutuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+		for i := 0; i < 25; i++ {
+			id = append(id, keyspace[rand.Intn(len(keyspace))])
+		}
+		return string(id)
+	}()
+
+	return &API{
+		Client: finance.New(z, finance.ZohoBooks),
+		id:     id,
+	}
+}
+
+// The models shared with Zoho Invoice are defined in the finance package
+type (
+	Response    = finance.Response
+	Error       = finance.Error
+	PageContext = finance.PageContext
+	CustomField = finance.CustomField
+	Address     = finance.Address
+	LineItem    = finance.LineItem
+	Tax         = finance.Tax
+
+	ContactType      = finance.ContactType
+	ContactRequest   = finance.ContactRequest
+	Contact          = finance.Contact
+	ContactsResponse = finance.ContactsResponse
+	ContactResponse  = finance.ContactResponse
+
+	ContactPerson          = finance.ContactPerson
+	ContactPersonsResponse = finance.ContactPersonsResponse
+	ContactPersonResponse  = finance.ContactPersonResponse
+
+	ItemRequest   = finance.ItemRequest
+	Item          = finance.Item
+	ItemsResponse = finance.ItemsResponse
+	ItemResponse  = finance.ItemResponse
+
+	InvoiceRequest        = finance.InvoiceRequest
+	PaymentOptions        = finance.PaymentOptions
+	Invoice               = finance.Invoice
+	InvoicesResponse      = finance.InvoicesResponse
+	InvoiceResponse       = finance.InvoiceResponse
+	EmailRequest          = finance.EmailRequest
+	InvoiceCreditsRequest = finance.InvoiceCreditsRequest
+	AppliedCreditNote     = finance.AppliedCreditNote
+	AppliedPayment        = finance.AppliedPayment
+
+	InvoicePayment                = finance.InvoicePayment
+	InvoicePaymentsResponse       = finance.InvoicePaymentsResponse
+	AppliedCredit                 = finance.AppliedCredit
+	InvoiceCreditsAppliedResponse = finance.InvoiceCreditsAppliedResponse
+	CommentRequest                = finance.CommentRequest
+	Comment                       = finance.Comment
+	InvoiceCommentsResponse       = finance.InvoiceCommentsResponse
+	InvoiceCommentResponse        = finance.InvoiceCommentResponse
+
+	CustomerPaymentRequest   = finance.CustomerPaymentRequest
+	AppliedInvoice           = finance.AppliedInvoice
+	CustomerPayment          = finance.CustomerPayment
+	CustomerPaymentsResponse = finance.CustomerPaymentsResponse
+	CustomerPaymentResponse  = finance.CustomerPaymentResponse
+)
+
+// The types of contacts
+const (
+	CustomerContact = finance.CustomerContact
+	VendorContact   = finance.VendorContact
+)
+
+// ForEachPage calls list with the 'page' parameter set to every page in turn, see finance.ForEachPage
+//
+//	var contacts []books.Contact
+//	err := books.ForEachPage(nil, func(params map[string]zoho.Parameter) (books.PageContext, error) {
+//	    resp, err := c.ListContacts(params)
+//	    contacts = append(contacts, resp.Contacts...)
+//	    return resp.PageContext, err
+//	})
+func ForEachPage(params map[string]zoho.Parameter, list func(params map[string]zoho.Parameter) (PageContext, error)) error {
+	return finance.ForEachPage(params, list)
+}
@@ -1 +1,232 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListAccounts will return a page of the accounts of the organization, filtered and paged with params
+// (eg. 'account_type', 'filter_by', 'sort_column', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/chart-of-accounts/#list-chart-of-accounts
+func (c *API) ListAccounts(params map[string]zoho.Parameter) (data AccountsResponse, err error) {
+	endpoint := c.ListEndpoint(ChartOfAccountsModule, c.URL(ChartOfAccountsModule), &AccountsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list accounts"); err != nil {
+		return AccountsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*AccountsResponse); ok {
+		return *v, nil
+	}
+
+	return AccountsResponse{}, fmt.Errorf("Data retrieved was not 'AccountsResponse'")
+}
+
+// GetAccount will return the account specified by id
+// https://www.zoho.com/books/api/v3/chart-of-accounts/#get-an-account
+func (c *API) GetAccount(id string) (data AccountResponse, err error) {
+	endpoint := c.NewEndpoint(ChartOfAccountsModule, zoho.HTTPGet, c.URL("%s/%s", ChartOfAccountsModule, id), &AccountResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve account (%s)", id)); err != nil {
+		return AccountResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*AccountResponse); ok {
+		return *v, nil
+	}
+
+	return AccountResponse{}, fmt.Errorf("Data retrieved was not 'AccountResponse'")
+}
+
+// CreateAccount will create the account in request, AccountName and AccountType are required
+// https://www.zoho.com/books/api/v3/chart-of-accounts/#create-an-account
+func (c *API) CreateAccount(request AccountRequest) (data AccountResponse, err error) {
+	endpoint := c.NewEndpoint(ChartOfAccountsModule, zoho.HTTPPost, c.URL(ChartOfAccountsModule), &AccountResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create account"); err != nil {
+		return AccountResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*AccountResponse); ok {
+		return *v, nil
+	}
+
+	return AccountResponse{}, fmt.Errorf("Data retrieved was not 'AccountResponse'")
+}
+
+// UpdateAccount will update the account specified by id with request
+// https://www.zoho.com/books/api/v3/chart-of-accounts/#update-an-account
+func (c *API) UpdateAccount(request AccountRequest, id string) (data AccountResponse, err error) {
+	endpoint := c.NewEndpoint(ChartOfAccountsModule, zoho.HTTPPut, c.URL("%s/%s", ChartOfAccountsModule, id), &AccountResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update account (%s)", id)); err != nil {
+		return AccountResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*AccountResponse); ok {
+		return *v, nil
+	}
+
+	return AccountResponse{}, fmt.Errorf("Data retrieved was not 'AccountResponse'")
+}
+
+// DeleteAccount will delete the account specified by id
+// https://www.zoho.com/books/api/v3/chart-of-accounts/#delete-an-account
+func (c *API) DeleteAccount(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ChartOfAccountsModule, zoho.HTTPDelete, c.URL("%s/%s", ChartOfAccountsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete account (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkAccountActive will mark the account specified by id as active
+// https://www.zoho.com/books/api/v3/chart-of-accounts/#mark-an-account-as-active
+func (c *API) MarkAccountActive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ChartOfAccountsModule, zoho.HTTPPost, c.URL("%s/%s/active", ChartOfAccountsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark account (%s) as active", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkAccountInactive will mark the account specified by id as inactive
+// https://www.zoho.com/books/api/v3/chart-of-accounts/#mark-an-account-as-inactive
+func (c *API) MarkAccountInactive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ChartOfAccountsModule, zoho.HTTPPost, c.URL("%s/%s/inactive", ChartOfAccountsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark account (%s) as inactive", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ListAccountTransactions will return a page of the transactions of the account accountID, filtered and paged with params
+// (eg. 'date.start', 'date.end', 'amount.less_than', 'transaction_type', 'sort_column', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/chart-of-accounts/#list-of-transactions-for-an-account
+func (c *API) ListAccountTransactions(accountID string, params map[string]zoho.Parameter) (data AccountTransactionsResponse, err error) {
+	endpoint := c.ListEndpoint(ChartOfAccountsModule, c.URL("%s/transactions", ChartOfAccountsModule), &AccountTransactionsResponse{}, params)
+	endpoint.URLParameters["account_id"] = zoho.Parameter(accountID)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list transactions of account (%s)", accountID)); err != nil {
+		return AccountTransactionsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*AccountTransactionsResponse); ok {
+		return *v, nil
+	}
+
+	return AccountTransactionsResponse{}, fmt.Errorf("Data retrieved was not 'AccountTransactionsResponse'")
+}
+
+// DeleteAccountTransaction will delete the transaction specified by id
+// https://www.zoho.com/books/api/v3/chart-of-accounts/#delete-a-transaction
+func (c *API) DeleteAccountTransaction(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ChartOfAccountsModule, zoho.HTTPDelete, c.URL("%s/transactions/%s", ChartOfAccountsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete account transaction (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// AccountRequest is the data provided to CreateAccount and UpdateAccount. AccountType is eg. "other_asset",
+// "other_current_asset", "cash", "bank", "fixed_asset", "other_current_liability", "credit_card", "long_term_liability",
+// "other_liability", "equity", "income", "other_income", "expense", "cost_of_goods_sold" or "other_expense"
+type AccountRequest struct {
+	AccountName        string        `json:"account_name,omitempty"`
+	AccountCode        string        `json:"account_code,omitempty"`
+	AccountType        string        `json:"account_type,omitempty"`
+	CurrencyID         string        `json:"currency_id,omitempty"`
+	Description        string        `json:"description,omitempty"`
+	ParentAccountID    string        `json:"parent_account_id,omitempty"`
+	ShowOnDashboard    bool          `json:"show_on_dashboard,omitempty"`
+	CanShowInZE        bool          `json:"can_show_in_ze,omitempty"`
+	IncludeInVATReturn bool          `json:"include_in_vat_return,omitempty"`
+	CustomFields       []CustomField `json:"custom_fields,omitempty"`
+}
+
+// Account is an account of the chart of accounts
+type Account struct {
+	AccountID           string        `json:"account_id,omitempty"`
+	AccountName         string        `json:"account_name,omitempty"`
+	AccountCode         string        `json:"account_code,omitempty"`
+	AccountType         string        `json:"account_type,omitempty"`
+	IsActive            bool          `json:"is_active,omitempty"`
+	IsUserCreated       bool          `json:"is_user_created,omitempty"`
+	IsSystemAccount     bool          `json:"is_system_account,omitempty"`
+	IsStandaloneAccount bool          `json:"is_standalone_account,omitempty"`
+	CurrencyID          string        `json:"currency_id,omitempty"`
+	CurrencyCode        string        `json:"currency_code,omitempty"`
+	Description         string        `json:"description,omitempty"`
+	ParentAccountID     string        `json:"parent_account_id,omitempty"`
+	ParentAccountName   string        `json:"parent_account_name,omitempty"`
+	Depth               int           `json:"depth,omitempty"`
+	HasAttachment       bool          `json:"has_attachment,omitempty"`
+	ClosingBalance      float64       `json:"closing_balance,omitempty"`
+	CreatedTime         string        `json:"created_time,omitempty"`
+	LastModifiedTime    string        `json:"last_modified_time,omitempty"`
+	CustomFields        []CustomField `json:"custom_fields,omitempty"`
+}
+
+// AccountsResponse is the data returned by ListAccounts
+type AccountsResponse struct {
+	Response
+	ChartOfAccounts []Account   `json:"chartofaccounts,omitempty"`
+	PageContext     PageContext `json:"page_context,omitempty"`
+}
+
+// AccountResponse is the data returned by GetAccount, CreateAccount and UpdateAccount
+type AccountResponse struct {
+	Response
+	ChartOfAccount Account `json:"chart_of_account,omitempty"`
+}
+
+// AccountTransactionsResponse is the data returned by ListAccountTransactions
+type AccountTransactionsResponse struct {
+	Response
+	Transactions []struct {
+		CategorizedTransactionID string        `json:"categorized_transaction_id,omitempty"`
+		TransactionType          string        `json:"transaction_type,omitempty"`
+		TransactionID            string        `json:"transaction_id,omitempty"`
+		TransactionDate          string        `json:"transaction_date,omitempty"`
+		TransactionTypeFormatted string        `json:"transaction_type_formatted,omitempty"`
+		AccountID                string        `json:"account_id,omitempty"`
+		CustomerID               string        `json:"customer_id,omitempty"`
+		Payee                    string        `json:"payee,omitempty"`
+		Description              string        `json:"description,omitempty"`
+		EntryNumber              string        `json:"entry_number,omitempty"`
+		CurrencyID               string        `json:"currency_id,omitempty"`
+		CurrencyCode             string        `json:"currency_code,omitempty"`
+		DebitOrCredit            DebitOrCredit `json:"debit_or_credit,omitempty"`
+		OffsetAccountName        string        `json:"offset_account_name,omitempty"`
+		ReferenceNumber          string        `json:"reference_number,omitempty"`
+		ReconcileStatus          string        `json:"reconcile_status,omitempty"`
+		DebitAmount              float64       `json:"debit_amount,omitempty"`
+		CreditAmount             float64       `json:"credit_amount,omitempty"`
+	} `json:"transactions,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
@@ -1 +1,213 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListCreditNotes will return a page of the credit notes of the organization, filtered and paged with params
+// (eg. 'customer_id', 'status', 'date_start', 'date_end', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/credit-notes/#list-all-credit-notes
+func (c *API) ListCreditNotes(params map[string]zoho.Parameter) (data CreditNotesResponse, err error) {
+	endpoint := c.ListEndpoint(CreditNotesModule, c.URL(CreditNotesModule), &CreditNotesResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list credit notes"); err != nil {
+		return CreditNotesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CreditNotesResponse); ok {
+		return *v, nil
+	}
+
+	return CreditNotesResponse{}, fmt.Errorf("Data retrieved was not 'CreditNotesResponse'")
+}
+
+// GetCreditNote will return the credit note specified by id
+// https://www.zoho.com/books/api/v3/credit-notes/#get-a-credit-note
+func (c *API) GetCreditNote(id string) (data CreditNoteResponse, err error) {
+	endpoint := c.NewEndpoint(CreditNotesModule, zoho.HTTPGet, c.URL("%s/%s", CreditNotesModule, id), &CreditNoteResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve credit note (%s)", id)); err != nil {
+		return CreditNoteResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CreditNoteResponse); ok {
+		return *v, nil
+	}
+
+	return CreditNoteResponse{}, fmt.Errorf("Data retrieved was not 'CreditNoteResponse'")
+}
+
+// CreateCreditNote will create the credit note in request, CustomerID and LineItems are required
+// https://www.zoho.com/books/api/v3/credit-notes/#create-a-credit-note
+func (c *API) CreateCreditNote(request CreditNoteRequest) (data CreditNoteResponse, err error) {
+	endpoint := c.NewEndpoint(CreditNotesModule, zoho.HTTPPost, c.URL(CreditNotesModule), &CreditNoteResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create credit note"); err != nil {
+		return CreditNoteResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CreditNoteResponse); ok {
+		return *v, nil
+	}
+
+	return CreditNoteResponse{}, fmt.Errorf("Data retrieved was not 'CreditNoteResponse'")
+}
+
+// UpdateCreditNote will update the credit note specified by id with request
+// https://www.zoho.com/books/api/v3/credit-notes/#update-a-credit-note
+func (c *API) UpdateCreditNote(request CreditNoteRequest, id string) (data CreditNoteResponse, err error) {
+	endpoint := c.NewEndpoint(CreditNotesModule, zoho.HTTPPut, c.URL("%s/%s", CreditNotesModule, id), &CreditNoteResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update credit note (%s)", id)); err != nil {
+		return CreditNoteResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CreditNoteResponse); ok {
+		return *v, nil
+	}
+
+	return CreditNoteResponse{}, fmt.Errorf("Data retrieved was not 'CreditNoteResponse'")
+}
+
+// DeleteCreditNote will delete the credit note specified by id
+// https://www.zoho.com/books/api/v3/credit-notes/#delete-a-credit-note
+func (c *API) DeleteCreditNote(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(CreditNotesModule, zoho.HTTPDelete, c.URL("%s/%s", CreditNotesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete credit note (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkCreditNoteOpen will mark the draft or void credit note specified by id as open
+// https://www.zoho.com/books/api/v3/credit-notes/#convert-credit-note-to-open
+func (c *API) MarkCreditNoteOpen(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(CreditNotesModule, zoho.HTTPPost, c.URL("%s/%s/status/open", CreditNotesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark credit note (%s) as open", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// VoidCreditNote will mark the credit note specified by id as void
+// https://www.zoho.com/books/api/v3/credit-notes/#void-a-credit-note
+func (c *API) VoidCreditNote(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(CreditNotesModule, zoho.HTTPPost, c.URL("%s/%s/status/void", CreditNotesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark credit note (%s) as void", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ApplyCreditNote will apply the credit note specified by id to the invoices of request
+// https://www.zoho.com/books/api/v3/credit-notes/#apply-credits-to-invoices
+func (c *API) ApplyCreditNote(request ApplyCreditsRequest, id string) (data ApplyCreditNoteResponse, err error) {
+	endpoint := c.NewEndpoint(CreditNotesModule, zoho.HTTPPost, c.URL("%s/%s/invoices", CreditNotesModule, id), &ApplyCreditNoteResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to apply credit note (%s)", id)); err != nil {
+		return ApplyCreditNoteResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ApplyCreditNoteResponse); ok {
+		return *v, nil
+	}
+
+	return ApplyCreditNoteResponse{}, fmt.Errorf("Data retrieved was not 'ApplyCreditNoteResponse'")
+}
+
+// CreditNoteRequest is the data provided to CreateCreditNote and UpdateCreditNote
+type CreditNoteRequest struct {
+	CustomerID       string   `json:"customer_id,omitempty"`
+	ContactPersons   []string `json:"contact_persons,omitempty"`
+	CreditNoteNumber string   `json:"creditnote_number,omitempty"`
+	ReferenceNumber  string   `json:"reference_number,omitempty"`
+	TemplateID       string   `json:"template_id,omitempty"`
+	Date             string   `json:"date,omitempty"`
+	CurrencyID       string   `json:"currency_id,omitempty"`
+	ExchangeRate     float64  `json:"exchange_rate,omitempty"`
+	IsInclusiveTax   bool     `json:"is_inclusive_tax,omitempty"`
+	// InvoiceID associates the credit note with the invoice it corrects
+	InvoiceID    string        `json:"invoice_id,omitempty"`
+	LineItems    []LineItem    `json:"line_items,omitempty"`
+	Notes        string        `json:"notes,omitempty"`
+	Terms        string        `json:"terms,omitempty"`
+	CustomFields []CustomField `json:"custom_fields,omitempty"`
+}
+
+// CreditNote is a credit issued to a customer
+type CreditNote struct {
+	CreditNoteID     string        `json:"creditnote_id,omitempty"`
+	CreditNoteNumber string        `json:"creditnote_number,omitempty"`
+	Status           string        `json:"status,omitempty"`
+	CustomerID       string        `json:"customer_id,omitempty"`
+	CustomerName     string        `json:"customer_name,omitempty"`
+	ContactPersons   []string      `json:"contact_persons,omitempty"`
+	ReferenceNumber  string        `json:"reference_number,omitempty"`
+	TemplateID       string        `json:"template_id,omitempty"`
+	Date             string        `json:"date,omitempty"`
+	CurrencyID       string        `json:"currency_id,omitempty"`
+	CurrencyCode     string        `json:"currency_code,omitempty"`
+	ExchangeRate     float64       `json:"exchange_rate,omitempty"`
+	IsInclusiveTax   bool          `json:"is_inclusive_tax,omitempty"`
+	InvoiceID        string        `json:"invoice_id,omitempty"`
+	InvoiceNumber    string        `json:"invoice_number,omitempty"`
+	LineItems        []LineItem    `json:"line_items,omitempty"`
+	SubTotal         float64       `json:"sub_total,omitempty"`
+	TaxTotal         float64       `json:"tax_total,omitempty"`
+	Total            float64       `json:"total,omitempty"`
+	Taxes            []Tax         `json:"taxes,omitempty"`
+	Balance          float64       `json:"balance,omitempty"`
+	BillingAddress   Address       `json:"billing_address,omitempty"`
+	ShippingAddress  Address       `json:"shipping_address,omitempty"`
+	Notes            string        `json:"notes,omitempty"`
+	Terms            string        `json:"terms,omitempty"`
+	CustomFields     []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime      string        `json:"created_time,omitempty"`
+	LastModifiedTime string        `json:"last_modified_time,omitempty"`
+}
+
+// CreditNotesResponse is the data returned by ListCreditNotes
+type CreditNotesResponse struct {
+	Response
+	CreditNotes []CreditNote `json:"creditnotes,omitempty"`
+	PageContext PageContext  `json:"page_context,omitempty"`
+}
+
+// CreditNoteResponse is the data returned by GetCreditNote, CreateCreditNote and UpdateCreditNote
+type CreditNoteResponse struct {
+	Response
+	CreditNote CreditNote `json:"creditnote,omitempty"`
+}
+
+// ApplyCreditsRequest is the data provided when applying credits to invoices
+type ApplyCreditsRequest struct {
+	Invoices []AppliedInvoice `json:"invoices"`
+}
+
+// ApplyCreditNoteResponse is the data returned by ApplyCreditNote
+type ApplyCreditNoteResponse struct {
+	Response
+	ApplyToInvoices struct {
+		Invoices []AppliedInvoice `json:"invoices,omitempty"`
+	} `json:"apply_to_invoices,omitempty"`
+}
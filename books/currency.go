@@ -1 +1,232 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListCurrencies will return the currencies of the organization, filtered and paged with params
+// (eg. 'filter_by', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/currency/#list-currencies
+func (c *API) ListCurrencies(params map[string]zoho.Parameter) (data CurrenciesResponse, err error) {
+	endpoint := c.ListEndpoint(CurrenciesModule, c.URL(CurrenciesModule), &CurrenciesResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list currencies"); err != nil {
+		return CurrenciesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CurrenciesResponse); ok {
+		return *v, nil
+	}
+
+	return CurrenciesResponse{}, fmt.Errorf("Data retrieved was not 'CurrenciesResponse'")
+}
+
+// GetCurrency will return the currency specified by id
+// https://www.zoho.com/books/api/v3/currency/#get-a-currency
+func (c *API) GetCurrency(id string) (data CurrencyResponse, err error) {
+	endpoint := c.NewEndpoint(CurrenciesModule, zoho.HTTPGet, c.URL("%s/%s", CurrenciesModule, id), &CurrencyResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve currency (%s)", id)); err != nil {
+		return CurrencyResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CurrencyResponse); ok {
+		return *v, nil
+	}
+
+	return CurrencyResponse{}, fmt.Errorf("Data retrieved was not 'CurrencyResponse'")
+}
+
+// CreateCurrency will create the currency in request, CurrencyCode and CurrencyFormat are required
+// https://www.zoho.com/books/api/v3/currency/#create-a-currency
+func (c *API) CreateCurrency(request CurrencyRequest) (data CurrencyResponse, err error) {
+	endpoint := c.NewEndpoint(CurrenciesModule, zoho.HTTPPost, c.URL(CurrenciesModule), &CurrencyResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create currency"); err != nil {
+		return CurrencyResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CurrencyResponse); ok {
+		return *v, nil
+	}
+
+	return CurrencyResponse{}, fmt.Errorf("Data retrieved was not 'CurrencyResponse'")
+}
+
+// UpdateCurrency will update the currency specified by id with request
+// https://www.zoho.com/books/api/v3/currency/#update-a-currency
+func (c *API) UpdateCurrency(request CurrencyRequest, id string) (data CurrencyResponse, err error) {
+	endpoint := c.NewEndpoint(CurrenciesModule, zoho.HTTPPut, c.URL("%s/%s", CurrenciesModule, id), &CurrencyResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update currency (%s)", id)); err != nil {
+		return CurrencyResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CurrencyResponse); ok {
+		return *v, nil
+	}
+
+	return CurrencyResponse{}, fmt.Errorf("Data retrieved was not 'CurrencyResponse'")
+}
+
+// DeleteCurrency will delete the currency specified by id
+// https://www.zoho.com/books/api/v3/currency/#delete-a-currency
+func (c *API) DeleteCurrency(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(CurrenciesModule, zoho.HTTPDelete, c.URL("%s/%s", CurrenciesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete currency (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ListExchangeRates will return the exchange rates of the currency currencyID, filtered with params
+// (eg. 'from_date', 'is_current_date', 'sort_column')
+// https://www.zoho.com/books/api/v3/currency/#list-exchange-rates
+func (c *API) ListExchangeRates(currencyID string, params map[string]zoho.Parameter) (data ExchangeRatesResponse, err error) {
+	endpoint := c.ListEndpoint(CurrenciesModule, c.URL("%s/%s/exchangerates", CurrenciesModule, currencyID), &ExchangeRatesResponse{}, params)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list exchange rates of currency (%s)", currencyID)); err != nil {
+		return ExchangeRatesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ExchangeRatesResponse); ok {
+		return *v, nil
+	}
+
+	return ExchangeRatesResponse{}, fmt.Errorf("Data retrieved was not 'ExchangeRatesResponse'")
+}
+
+// GetExchangeRate will return the exchange rate id of the currency currencyID
+// https://www.zoho.com/books/api/v3/currency/#get-an-exchange-rate
+func (c *API) GetExchangeRate(currencyID string, id string) (data ExchangeRateResponse, err error) {
+	endpoint := c.NewEndpoint(CurrenciesModule, zoho.HTTPGet, c.URL("%s/%s/exchangerates/%s", CurrenciesModule, currencyID, id), &ExchangeRateResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve exchange rate (%s) of currency (%s)", id, currencyID)); err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ExchangeRateResponse); ok {
+		return *v, nil
+	}
+
+	return ExchangeRateResponse{}, fmt.Errorf("Data retrieved was not 'ExchangeRateResponse'")
+}
+
+// CreateExchangeRate will create the exchange rate in request for the currency currencyID, EffectiveDate and Rate are required
+// https://www.zoho.com/books/api/v3/currency/#create-an-exchange-rate
+func (c *API) CreateExchangeRate(request ExchangeRateRequest, currencyID string) (data ExchangeRateResponse, err error) {
+	endpoint := c.NewEndpoint(CurrenciesModule, zoho.HTTPPost, c.URL("%s/%s/exchangerates", CurrenciesModule, currencyID), &ExchangeRateResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to create exchange rate of currency (%s)", currencyID)); err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ExchangeRateResponse); ok {
+		return *v, nil
+	}
+
+	return ExchangeRateResponse{}, fmt.Errorf("Data retrieved was not 'ExchangeRateResponse'")
+}
+
+// UpdateExchangeRate will update the exchange rate id of the currency currencyID with request
+// https://www.zoho.com/books/api/v3/currency/#update-an-exchange-rate
+func (c *API) UpdateExchangeRate(request ExchangeRateRequest, currencyID string, id string) (data ExchangeRateResponse, err error) {
+	endpoint := c.NewEndpoint(CurrenciesModule, zoho.HTTPPut, c.URL("%s/%s/exchangerates/%s", CurrenciesModule, currencyID, id), &ExchangeRateResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update exchange rate (%s) of currency (%s)", id, currencyID)); err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ExchangeRateResponse); ok {
+		return *v, nil
+	}
+
+	return ExchangeRateResponse{}, fmt.Errorf("Data retrieved was not 'ExchangeRateResponse'")
+}
+
+// DeleteExchangeRate will delete the exchange rate id of the currency currencyID
+// https://www.zoho.com/books/api/v3/currency/#delete-an-exchange-rate
+func (c *API) DeleteExchangeRate(currencyID string, id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(CurrenciesModule, zoho.HTTPDelete, c.URL("%s/%s/exchangerates/%s", CurrenciesModule, currencyID, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete exchange rate (%s) of currency (%s)", id, currencyID)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// CurrencyRequest is the data provided to CreateCurrency and UpdateCurrency, CurrencyFormat is eg. "1,234,567.89"
+type CurrencyRequest struct {
+	CurrencyCode   string `json:"currency_code,omitempty"`
+	CurrencySymbol string `json:"currency_symbol,omitempty"`
+	PricePrecision int    `json:"price_precision,omitempty"`
+	CurrencyFormat string `json:"currency_format,omitempty"`
+}
+
+// Currency is a currency transactions of the organization can be made in
+type Currency struct {
+	CurrencyID     string  `json:"currency_id,omitempty"`
+	CurrencyCode   string  `json:"currency_code,omitempty"`
+	CurrencyName   string  `json:"currency_name,omitempty"`
+	CurrencySymbol string  `json:"currency_symbol,omitempty"`
+	PricePrecision int     `json:"price_precision,omitempty"`
+	CurrencyFormat string  `json:"currency_format,omitempty"`
+	IsBaseCurrency bool    `json:"is_base_currency,omitempty"`
+	ExchangeRate   float64 `json:"exchange_rate,omitempty"`
+	EffectiveDate  string  `json:"effective_date,omitempty"`
+}
+
+// CurrenciesResponse is the data returned by ListCurrencies
+type CurrenciesResponse struct {
+	Response
+	Currencies  []Currency  `json:"currencies,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// CurrencyResponse is the data returned by GetCurrency, CreateCurrency and UpdateCurrency
+type CurrencyResponse struct {
+	Response
+	Currency Currency `json:"currency,omitempty"`
+}
+
+// ExchangeRateRequest is the data provided to CreateExchangeRate and UpdateExchangeRate, Rate is the value of
+// 1 unit of the currency in the base currency of the organization
+type ExchangeRateRequest struct {
+	EffectiveDate string  `json:"effective_date,omitempty"`
+	Rate          float64 `json:"rate,omitempty"`
+}
+
+// ExchangeRate is the rate of a currency from a date
+type ExchangeRate struct {
+	ExchangeRateID string  `json:"exchange_rate_id,omitempty"`
+	CurrencyID     string  `json:"currency_id,omitempty"`
+	CurrencyCode   string  `json:"currency_code,omitempty"`
+	EffectiveDate  string  `json:"effective_date,omitempty"`
+	Rate           float64 `json:"rate,omitempty"`
+}
+
+// ExchangeRatesResponse is the data returned by ListExchangeRates
+type ExchangeRatesResponse struct {
+	Response
+	ExchangeRates []ExchangeRate `json:"exchange_rates,omitempty"`
+	PageContext   PageContext    `json:"page_context,omitempty"`
+}
+
+// ExchangeRateResponse is the data returned by GetExchangeRate, CreateExchangeRate and UpdateExchangeRate
+type ExchangeRateResponse struct {
+	Response
+	ExchangeRate ExchangeRate `json:"exchange_rate,omitempty"`
+}
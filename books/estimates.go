@@ -1 +1,223 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListEstimates will return a page of the estimates of the organization, filtered and paged with params
+// (eg. 'customer_id', 'status', 'date_start', 'date_end', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/estimates/#list-estimates
+func (c *API) ListEstimates(params map[string]zoho.Parameter) (data EstimatesResponse, err error) {
+	endpoint := c.ListEndpoint(EstimatesModule, c.URL(EstimatesModule), &EstimatesResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list estimates"); err != nil {
+		return EstimatesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*EstimatesResponse); ok {
+		return *v, nil
+	}
+
+	return EstimatesResponse{}, fmt.Errorf("Data retrieved was not 'EstimatesResponse'")
+}
+
+// GetEstimate will return the estimate specified by id
+// https://www.zoho.com/books/api/v3/estimates/#get-an-estimate
+func (c *API) GetEstimate(id string) (data EstimateResponse, err error) {
+	endpoint := c.NewEndpoint(EstimatesModule, zoho.HTTPGet, c.URL("%s/%s", EstimatesModule, id), &EstimateResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve estimate (%s)", id)); err != nil {
+		return EstimateResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*EstimateResponse); ok {
+		return *v, nil
+	}
+
+	return EstimateResponse{}, fmt.Errorf("Data retrieved was not 'EstimateResponse'")
+}
+
+// CreateEstimate will create the estimate in request, CustomerID and LineItems are required
+// https://www.zoho.com/books/api/v3/estimates/#create-an-estimate
+func (c *API) CreateEstimate(request EstimateRequest) (data EstimateResponse, err error) {
+	endpoint := c.NewEndpoint(EstimatesModule, zoho.HTTPPost, c.URL(EstimatesModule), &EstimateResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create estimate"); err != nil {
+		return EstimateResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*EstimateResponse); ok {
+		return *v, nil
+	}
+
+	return EstimateResponse{}, fmt.Errorf("Data retrieved was not 'EstimateResponse'")
+}
+
+// UpdateEstimate will update the estimate specified by id with request
+// https://www.zoho.com/books/api/v3/estimates/#update-an-estimate
+func (c *API) UpdateEstimate(request EstimateRequest, id string) (data EstimateResponse, err error) {
+	endpoint := c.NewEndpoint(EstimatesModule, zoho.HTTPPut, c.URL("%s/%s", EstimatesModule, id), &EstimateResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update estimate (%s)", id)); err != nil {
+		return EstimateResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*EstimateResponse); ok {
+		return *v, nil
+	}
+
+	return EstimateResponse{}, fmt.Errorf("Data retrieved was not 'EstimateResponse'")
+}
+
+// DeleteEstimate will delete the estimate specified by id
+// https://www.zoho.com/books/api/v3/estimates/#delete-an-estimate
+func (c *API) DeleteEstimate(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(EstimatesModule, zoho.HTTPDelete, c.URL("%s/%s", EstimatesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete estimate (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkEstimateSent will mark the estimate specified by id as sent
+// https://www.zoho.com/books/api/v3/estimates/#mark-an-estimate-as-sent
+func (c *API) MarkEstimateSent(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(EstimatesModule, zoho.HTTPPost, c.URL("%s/%s/status/sent", EstimatesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark estimate (%s) as sent", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// AcceptEstimate will mark the estimate specified by id as accepted by the customer
+// https://www.zoho.com/books/api/v3/estimates/#mark-an-estimate-as-accepted
+func (c *API) AcceptEstimate(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(EstimatesModule, zoho.HTTPPost, c.URL("%s/%s/status/accepted", EstimatesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark estimate (%s) as accepted", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// DeclineEstimate will mark the estimate specified by id as declined by the customer
+// https://www.zoho.com/books/api/v3/estimates/#mark-an-estimate-as-declined
+func (c *API) DeclineEstimate(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(EstimatesModule, zoho.HTTPPost, c.URL("%s/%s/status/declined", EstimatesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark estimate (%s) as declined", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// EstimateRequest is the data provided to CreateEstimate and UpdateEstimate
+type EstimateRequest struct {
+	CustomerID            string        `json:"customer_id,omitempty"`
+	ContactPersons        []string      `json:"contact_persons,omitempty"`
+	EstimateNumber        string        `json:"estimate_number,omitempty"`
+	ReferenceNumber       string        `json:"reference_number,omitempty"`
+	TemplateID            string        `json:"template_id,omitempty"`
+	Date                  string        `json:"date,omitempty"`
+	ExpiryDate            string        `json:"expiry_date,omitempty"`
+	CurrencyID            string        `json:"currency_id,omitempty"`
+	ExchangeRate          float64       `json:"exchange_rate,omitempty"`
+	Discount              interface{}   `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool          `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string        `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool          `json:"is_inclusive_tax,omitempty"`
+	SalespersonName       string        `json:"salesperson_name,omitempty"`
+	ProjectID             string        `json:"project_id,omitempty"`
+	LineItems             []LineItem    `json:"line_items,omitempty"`
+	CustomBody            string        `json:"custom_body,omitempty"`
+	CustomSubject         string        `json:"custom_subject,omitempty"`
+	Notes                 string        `json:"notes,omitempty"`
+	Terms                 string        `json:"terms,omitempty"`
+	ShippingCharge        float64       `json:"shipping_charge,omitempty"`
+	Adjustment            float64       `json:"adjustment,omitempty"`
+	AdjustmentDescription string        `json:"adjustment_description,omitempty"`
+	BillingAddressID      string        `json:"billing_address_id,omitempty"`
+	ShippingAddressID     string        `json:"shipping_address_id,omitempty"`
+	TaxAuthorityID        string        `json:"tax_authority_id,omitempty"`
+	TaxExemptionID        string        `json:"tax_exemption_id,omitempty"`
+	CustomFields          []CustomField `json:"custom_fields,omitempty"`
+}
+
+// Estimate is a quote sent to a customer
+type Estimate struct {
+	EstimateID            string        `json:"estimate_id,omitempty"`
+	EstimateNumber        string        `json:"estimate_number,omitempty"`
+	Status                string        `json:"status,omitempty"`
+	CustomerID            string        `json:"customer_id,omitempty"`
+	CustomerName          string        `json:"customer_name,omitempty"`
+	ContactPersons        []string      `json:"contact_persons,omitempty"`
+	ReferenceNumber       string        `json:"reference_number,omitempty"`
+	TemplateID            string        `json:"template_id,omitempty"`
+	Date                  string        `json:"date,omitempty"`
+	ExpiryDate            string        `json:"expiry_date,omitempty"`
+	CurrencyID            string        `json:"currency_id,omitempty"`
+	CurrencyCode          string        `json:"currency_code,omitempty"`
+	ExchangeRate          float64       `json:"exchange_rate,omitempty"`
+	Discount              float64       `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool          `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string        `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool          `json:"is_inclusive_tax,omitempty"`
+	SalespersonID         string        `json:"salesperson_id,omitempty"`
+	SalespersonName       string        `json:"salesperson_name,omitempty"`
+	ProjectID             string        `json:"project_id,omitempty"`
+	LineItems             []LineItem    `json:"line_items,omitempty"`
+	ShippingCharge        float64       `json:"shipping_charge,omitempty"`
+	Adjustment            float64       `json:"adjustment,omitempty"`
+	AdjustmentDescription string        `json:"adjustment_description,omitempty"`
+	SubTotal              float64       `json:"sub_total,omitempty"`
+	TaxTotal              float64       `json:"tax_total,omitempty"`
+	Total                 float64       `json:"total,omitempty"`
+	Taxes                 []Tax         `json:"taxes,omitempty"`
+	AcceptedDate          string        `json:"accepted_date,omitempty"`
+	DeclinedDate          string        `json:"declined_date,omitempty"`
+	IsEmailed             bool          `json:"is_emailed,omitempty"`
+	BillingAddress        Address       `json:"billing_address,omitempty"`
+	ShippingAddress       Address       `json:"shipping_address,omitempty"`
+	Notes                 string        `json:"notes,omitempty"`
+	Terms                 string        `json:"terms,omitempty"`
+	CustomFields          []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime           string        `json:"created_time,omitempty"`
+	LastModifiedTime      string        `json:"last_modified_time,omitempty"`
+}
+
+// EstimatesResponse is the data returned by ListEstimates
+type EstimatesResponse struct {
+	Response
+	Estimates   []Estimate  `json:"estimates,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// EstimateResponse is the data returned by GetEstimate, CreateEstimate and UpdateEstimate
+type EstimateResponse struct {
+	Response
+	Estimate Estimate `json:"estimate,omitempty"`
+}
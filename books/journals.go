@@ -1 +1,230 @@
 package books
+
+import (
+	"fmt"
+	"math"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListJournals will return a page of the journals of the organization, filtered and paged with params
+// (eg. 'entry_number', 'reference_number', 'date', 'last_modified_time', 'filter_by', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/journals/#get-journal-list
+func (c *API) ListJournals(params map[string]zoho.Parameter) (data JournalsResponse, err error) {
+	endpoint := c.ListEndpoint(JournalsModule, c.URL(JournalsModule), &JournalsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list journals"); err != nil {
+		return JournalsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*JournalsResponse); ok {
+		return *v, nil
+	}
+
+	return JournalsResponse{}, fmt.Errorf("Data retrieved was not 'JournalsResponse'")
+}
+
+// GetJournal will return the journal specified by id
+// https://www.zoho.com/books/api/v3/journals/#get-journal
+func (c *API) GetJournal(id string) (data JournalResponse, err error) {
+	endpoint := c.NewEndpoint(JournalsModule, zoho.HTTPGet, c.URL("%s/%s", JournalsModule, id), &JournalResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve journal (%s)", id)); err != nil {
+		return JournalResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*JournalResponse); ok {
+		return *v, nil
+	}
+
+	return JournalResponse{}, fmt.Errorf("Data retrieved was not 'JournalResponse'")
+}
+
+// CreateJournal will create the journal in request, JournalDate and LineItems are required.
+// request is checked with Validate before it is sent, a journal whose debits and credits do not balance is not created.
+// https://www.zoho.com/books/api/v3/journals/#create-a-journal
+func (c *API) CreateJournal(request JournalRequest) (data JournalResponse, err error) {
+	if err = request.Validate(); err != nil {
+		return JournalResponse{}, fmt.Errorf("Failed to create journal: %s", err)
+	}
+
+	endpoint := c.NewEndpoint(JournalsModule, zoho.HTTPPost, c.URL(JournalsModule), &JournalResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create journal"); err != nil {
+		return JournalResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*JournalResponse); ok {
+		return *v, nil
+	}
+
+	return JournalResponse{}, fmt.Errorf("Data retrieved was not 'JournalResponse'")
+}
+
+// UpdateJournal will update the journal specified by id with request, request is checked with Validate before it is sent
+// https://www.zoho.com/books/api/v3/journals/#update-a-journal
+func (c *API) UpdateJournal(request JournalRequest, id string) (data JournalResponse, err error) {
+	if err = request.Validate(); err != nil {
+		return JournalResponse{}, fmt.Errorf("Failed to update journal (%s): %s", id, err)
+	}
+
+	endpoint := c.NewEndpoint(JournalsModule, zoho.HTTPPut, c.URL("%s/%s", JournalsModule, id), &JournalResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update journal (%s)", id)); err != nil {
+		return JournalResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*JournalResponse); ok {
+		return *v, nil
+	}
+
+	return JournalResponse{}, fmt.Errorf("Data retrieved was not 'JournalResponse'")
+}
+
+// DeleteJournal will delete the journal specified by id
+// https://www.zoho.com/books/api/v3/journals/#delete-a-journal
+func (c *API) DeleteJournal(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(JournalsModule, zoho.HTTPDelete, c.URL("%s/%s", JournalsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete journal (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// PublishJournal will publish the draft journal specified by id
+// https://www.zoho.com/books/api/v3/journals/#mark-a-journal-as-published
+func (c *API) PublishJournal(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(JournalsModule, zoho.HTTPPost, c.URL("%s/%s/status/publish", JournalsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to publish journal (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// JournalRequest is the data provided to CreateJournal and UpdateJournal, JournalType is "both" (the default) or "cash"
+type JournalRequest struct {
+	JournalDate     string  `json:"journal_date,omitempty"`
+	ReferenceNumber string  `json:"reference_number,omitempty"`
+	Notes           string  `json:"notes,omitempty"`
+	JournalType     string  `json:"journal_type,omitempty"`
+	CurrencyID      string  `json:"currency_id,omitempty"`
+	ExchangeRate    float64 `json:"exchange_rate,omitempty"`
+	// Status is "draft" or "published", journals created as drafts are published with PublishJournal
+	Status       string            `json:"status,omitempty"`
+	LineItems    []JournalLineItem `json:"line_items,omitempty"`
+	CustomFields []CustomField     `json:"custom_fields,omitempty"`
+}
+
+// JournalLineItem is a debit or a credit of an account in a journal, Amount is always positive
+type JournalLineItem struct {
+	LineID         string        `json:"line_id,omitempty"`
+	AccountID      string        `json:"account_id,omitempty"`
+	AccountName    string        `json:"account_name,omitempty"`
+	CustomerID     string        `json:"customer_id,omitempty"`
+	Description    string        `json:"description,omitempty"`
+	DebitOrCredit  DebitOrCredit `json:"debit_or_credit,omitempty"`
+	Amount         float64       `json:"amount,omitempty"`
+	TaxID          string        `json:"tax_id,omitempty"`
+	TaxExemptionID string        `json:"tax_exemption_id,omitempty"`
+	TaxAuthorityID string        `json:"tax_authority_id,omitempty"`
+	ProjectID      string        `json:"project_id,omitempty"`
+}
+
+// Debit adds a line debiting amount from the account accountID to the journal
+func (r *JournalRequest) Debit(accountID string, amount float64, description string) *JournalRequest {
+	r.LineItems = append(r.LineItems, JournalLineItem{AccountID: accountID, DebitOrCredit: Debit, Amount: amount, Description: description})
+	return r
+}
+
+// Credit adds a line crediting amount to the account accountID to the journal
+func (r *JournalRequest) Credit(accountID string, amount float64, description string) *JournalRequest {
+	r.LineItems = append(r.LineItems, JournalLineItem{AccountID: accountID, DebitOrCredit: Credit, Amount: amount, Description: description})
+	return r
+}
+
+// Totals returns the sums of the debits and credits of the journal, rounded to the cent
+func (r JournalRequest) Totals() (debits float64, credits float64) {
+	var d, c int64
+	for _, l := range r.LineItems {
+		switch l.DebitOrCredit {
+		case Debit:
+			d += cents(l.Amount)
+		case Credit:
+			c += cents(l.Amount)
+		}
+	}
+	return float64(d) / 100, float64(c) / 100
+}
+
+// Validate checks that the journal has at least 2 lines, that every line has an account and a positive amount
+// which is a debit or a credit, and that the debits and credits balance
+func (r JournalRequest) Validate() error {
+	if len(r.LineItems) < 2 {
+		return fmt.Errorf("a journal must have at least 2 line items, %d provided", len(r.LineItems))
+	}
+	for i, l := range r.LineItems {
+		if l.AccountID == "" {
+			return fmt.Errorf("line item %d has no account", i+1)
+		}
+		if l.DebitOrCredit != Debit && l.DebitOrCredit != Credit {
+			return fmt.Errorf("line item %d is neither a debit nor a credit", i+1)
+		}
+		if cents(l.Amount) <= 0 {
+			return fmt.Errorf("line item %d must have a positive amount, %v provided", i+1, l.Amount)
+		}
+	}
+	if debits, credits := r.Totals(); debits != credits {
+		return fmt.Errorf("debits (%.2f) and credits (%.2f) do not balance", debits, credits)
+	}
+	return nil
+}
+
+// cents returns amount in cents, rounded to avoid floating point errors when amounts are summed
+func cents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// Journal is a manual journal of the organization
+type Journal struct {
+	JournalID        string            `json:"journal_id,omitempty"`
+	EntryNumber      string            `json:"entry_number,omitempty"`
+	JournalDate      string            `json:"journal_date,omitempty"`
+	JournalType      string            `json:"journal_type,omitempty"`
+	Status           string            `json:"status,omitempty"`
+	ReferenceNumber  string            `json:"reference_number,omitempty"`
+	Notes            string            `json:"notes,omitempty"`
+	CurrencyID       string            `json:"currency_id,omitempty"`
+	CurrencyCode     string            `json:"currency_code,omitempty"`
+	ExchangeRate     float64           `json:"exchange_rate,omitempty"`
+	LineItems        []JournalLineItem `json:"line_items,omitempty"`
+	LineItemTotal    float64           `json:"line_item_total,omitempty"`
+	Total            float64           `json:"total,omitempty"`
+	CustomFields     []CustomField     `json:"custom_fields,omitempty"`
+	CreatedTime      string            `json:"created_time,omitempty"`
+	LastModifiedTime string            `json:"last_modified_time,omitempty"`
+}
+
+// JournalsResponse is the data returned by ListJournals
+type JournalsResponse struct {
+	Response
+	Journals    []Journal   `json:"journals,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// JournalResponse is the data returned by GetJournal, CreateJournal and UpdateJournal
+type JournalResponse struct {
+	Response
+	Journal Journal `json:"journal,omitempty"`
+}
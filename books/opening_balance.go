@@ -1 +1,101 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// GetOpeningBalance will return the opening balance of the accounts of the organization
+// https://www.zoho.com/books/api/v3/opening-balance/#get-opening-balance
+func (c *API) GetOpeningBalance() (data OpeningBalanceResponse, err error) {
+	endpoint := c.NewEndpoint(OpeningBalancesModule, zoho.HTTPGet, c.URL(OpeningBalancesModule), &OpeningBalanceResponse{}, nil)
+
+	if err = c.Send(&endpoint, "Failed to retrieve opening balance"); err != nil {
+		return OpeningBalanceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*OpeningBalanceResponse); ok {
+		return *v, nil
+	}
+
+	return OpeningBalanceResponse{}, fmt.Errorf("Data retrieved was not 'OpeningBalanceResponse'")
+}
+
+// CreateOpeningBalance will create the opening balance of the accounts in request, Date and Accounts are required.
+// The debits and credits of the accounts must balance.
+// https://www.zoho.com/books/api/v3/opening-balance/#create-opening-balance
+func (c *API) CreateOpeningBalance(request OpeningBalanceRequest) (data OpeningBalanceResponse, err error) {
+	endpoint := c.NewEndpoint(OpeningBalancesModule, zoho.HTTPPost, c.URL(OpeningBalancesModule), &OpeningBalanceResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create opening balance"); err != nil {
+		return OpeningBalanceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*OpeningBalanceResponse); ok {
+		return *v, nil
+	}
+
+	return OpeningBalanceResponse{}, fmt.Errorf("Data retrieved was not 'OpeningBalanceResponse'")
+}
+
+// UpdateOpeningBalance will replace the opening balance with request
+// https://www.zoho.com/books/api/v3/opening-balance/#update-opening-balance
+func (c *API) UpdateOpeningBalance(request OpeningBalanceRequest) (data OpeningBalanceResponse, err error) {
+	endpoint := c.NewEndpoint(OpeningBalancesModule, zoho.HTTPPut, c.URL(OpeningBalancesModule), &OpeningBalanceResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to update opening balance"); err != nil {
+		return OpeningBalanceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*OpeningBalanceResponse); ok {
+		return *v, nil
+	}
+
+	return OpeningBalanceResponse{}, fmt.Errorf("Data retrieved was not 'OpeningBalanceResponse'")
+}
+
+// DeleteOpeningBalance will delete the opening balance
+// https://www.zoho.com/books/api/v3/opening-balance/#delete-opening-balance
+func (c *API) DeleteOpeningBalance() (data Response, err error) {
+	endpoint := c.NewEndpoint(OpeningBalancesModule, zoho.HTTPDelete, c.URL(OpeningBalancesModule), &Response{}, nil)
+
+	if err = c.Send(&endpoint, "Failed to delete opening balance"); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// OpeningBalanceRequest is the data provided to CreateOpeningBalance and UpdateOpeningBalance
+type OpeningBalanceRequest struct {
+	Date     string                  `json:"date,omitempty"`
+	Accounts []OpeningBalanceAccount `json:"accounts,omitempty"`
+}
+
+// OpeningBalanceAccount is the opening balance of an account, Amount is always positive
+type OpeningBalanceAccount struct {
+	AccountID     string        `json:"account_id,omitempty"`
+	AccountName   string        `json:"account_name,omitempty"`
+	DebitOrCredit DebitOrCredit `json:"debit_or_credit,omitempty"`
+	Amount        float64       `json:"amount,omitempty"`
+	CurrencyID    string        `json:"currency_id,omitempty"`
+	CurrencyCode  string        `json:"currency_code,omitempty"`
+	ExchangeRate  float64       `json:"exchange_rate,omitempty"`
+	BCYAmount     float64       `json:"bcy_amount,omitempty"`
+}
+
+// OpeningBalanceResponse is the data returned by GetOpeningBalance, CreateOpeningBalance and UpdateOpeningBalance
+type OpeningBalanceResponse struct {
+	Response
+	OpeningBalance struct {
+		OpeningBalanceID string                  `json:"opening_balance_id,omitempty"`
+		Date             string                  `json:"date,omitempty"`
+		Accounts         []OpeningBalanceAccount `json:"accounts,omitempty"`
+		Total            float64                 `json:"total,omitempty"`
+	} `json:"opening_balance,omitempty"`
+}
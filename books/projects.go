@@ -1 +1,334 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListProjects will return a page of the projects of the organization, filtered and paged with params
+// (eg. 'customer_id', 'filter_by', 'search_text', 'sort_column', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/projects/#list-projects
+func (c *API) ListProjects(params map[string]zoho.Parameter) (data ProjectsResponse, err error) {
+	endpoint := c.ListEndpoint(ProjectsModule, c.URL(ProjectsModule), &ProjectsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list projects"); err != nil {
+		return ProjectsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectsResponse); ok {
+		return *v, nil
+	}
+
+	return ProjectsResponse{}, fmt.Errorf("Data retrieved was not 'ProjectsResponse'")
+}
+
+// GetProject will return the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#get-a-project
+func (c *API) GetProject(id string) (data ProjectResponse, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPGet, c.URL("%s/%s", ProjectsModule, id), &ProjectResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve project (%s)", id)); err != nil {
+		return ProjectResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectResponse); ok {
+		return *v, nil
+	}
+
+	return ProjectResponse{}, fmt.Errorf("Data retrieved was not 'ProjectResponse'")
+}
+
+// CreateProject will create the project in request, ProjectName, CustomerID and BillingType are required
+// https://www.zoho.com/books/api/v3/projects/#create-a-project
+func (c *API) CreateProject(request ProjectRequest) (data ProjectResponse, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPPost, c.URL(ProjectsModule), &ProjectResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create project"); err != nil {
+		return ProjectResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectResponse); ok {
+		return *v, nil
+	}
+
+	return ProjectResponse{}, fmt.Errorf("Data retrieved was not 'ProjectResponse'")
+}
+
+// UpdateProject will update the project specified by id with request
+// https://www.zoho.com/books/api/v3/projects/#update-a-project
+func (c *API) UpdateProject(request ProjectRequest, id string) (data ProjectResponse, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPPut, c.URL("%s/%s", ProjectsModule, id), &ProjectResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update project (%s)", id)); err != nil {
+		return ProjectResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectResponse); ok {
+		return *v, nil
+	}
+
+	return ProjectResponse{}, fmt.Errorf("Data retrieved was not 'ProjectResponse'")
+}
+
+// DeleteProject will delete the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#delete-project
+func (c *API) DeleteProject(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPDelete, c.URL("%s/%s", ProjectsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete project (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkProjectActive will mark the project specified by id as active
+// https://www.zoho.com/books/api/v3/projects/#activate-project
+func (c *API) MarkProjectActive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPPost, c.URL("%s/%s/active", ProjectsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark project (%s) as active", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkProjectInactive will mark the project specified by id as inactive
+// https://www.zoho.com/books/api/v3/projects/#inactivate-a-project
+func (c *API) MarkProjectInactive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPPost, c.URL("%s/%s/inactive", ProjectsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark project (%s) as inactive", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// CloneProject will create a copy of the project specified by id, named and described as in request
+// https://www.zoho.com/books/api/v3/projects/#clone-project
+func (c *API) CloneProject(request ProjectRequest, id string) (data ProjectResponse, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPPost, c.URL("%s/%s/clone", ProjectsModule, id), &ProjectResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to clone project (%s)", id)); err != nil {
+		return ProjectResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectResponse); ok {
+		return *v, nil
+	}
+
+	return ProjectResponse{}, fmt.Errorf("Data retrieved was not 'ProjectResponse'")
+}
+
+// ListProjectUsers will return the users assigned to the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#list-users
+func (c *API) ListProjectUsers(id string) (data ProjectUsersResponse, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPGet, c.URL("%s/%s/users", ProjectsModule, id), &ProjectUsersResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list users of project (%s)", id)); err != nil {
+		return ProjectUsersResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectUsersResponse); ok {
+		return *v, nil
+	}
+
+	return ProjectUsersResponse{}, fmt.Errorf("Data retrieved was not 'ProjectUsersResponse'")
+}
+
+// GetProjectUser will return the user userID of the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#get-a-user
+func (c *API) GetProjectUser(id string, userID string) (data ProjectUserResponse, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPGet, c.URL("%s/%s/users/%s", ProjectsModule, id, userID), &ProjectUserResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve user (%s) of project (%s)", userID, id)); err != nil {
+		return ProjectUserResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectUserResponse); ok {
+		return *v, nil
+	}
+
+	return ProjectUserResponse{}, fmt.Errorf("Data retrieved was not 'ProjectUserResponse'")
+}
+
+// AssignProjectUsers will assign the users of request to the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#assign-users
+func (c *API) AssignProjectUsers(request AssignProjectUsersRequest, id string) (data ProjectUsersResponse, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPPost, c.URL("%s/%s/users", ProjectsModule, id), &ProjectUsersResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to assign users to project (%s)", id)); err != nil {
+		return ProjectUsersResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectUsersResponse); ok {
+		return *v, nil
+	}
+
+	return ProjectUsersResponse{}, fmt.Errorf("Data retrieved was not 'ProjectUsersResponse'")
+}
+
+// InviteProjectUser will invite the user of request, who is not yet a user of the organization, to the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#invite-user
+func (c *API) InviteProjectUser(request ProjectUser, id string) (data ProjectUserResponse, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPPost, c.URL("%s/%s/users/invite", ProjectsModule, id), &ProjectUserResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to invite user to project (%s)", id)); err != nil {
+		return ProjectUserResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectUserResponse); ok {
+		return *v, nil
+	}
+
+	return ProjectUserResponse{}, fmt.Errorf("Data retrieved was not 'ProjectUserResponse'")
+}
+
+// UpdateProjectUser will update the rate, budget and role of the user userID of the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#update-user
+func (c *API) UpdateProjectUser(request ProjectUser, id string, userID string) (data ProjectUserResponse, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPPut, c.URL("%s/%s/users/%s", ProjectsModule, id, userID), &ProjectUserResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update user (%s) of project (%s)", userID, id)); err != nil {
+		return ProjectUserResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectUserResponse); ok {
+		return *v, nil
+	}
+
+	return ProjectUserResponse{}, fmt.Errorf("Data retrieved was not 'ProjectUserResponse'")
+}
+
+// DeleteProjectUser will remove the user userID from the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#delete-user
+func (c *API) DeleteProjectUser(id string, userID string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ProjectsModule, zoho.HTTPDelete, c.URL("%s/%s/users/%s", ProjectsModule, id, userID), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete user (%s) of project (%s)", userID, id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ProjectBillingType is how the time of a project is billed
+type ProjectBillingType string
+
+// The billing types of projects
+const (
+	FixedCostForProject ProjectBillingType = "fixed_cost_for_project"
+	BasedOnProjectHours ProjectBillingType = "based_on_project_hours"
+	BasedOnStaffHours   ProjectBillingType = "based_on_staff_hours"
+	BasedOnTaskHours    ProjectBillingType = "based_on_task_hours"
+)
+
+// ProjectRequest is the data provided to CreateProject, UpdateProject and CloneProject.
+// Rate is the hourly rate of BasedOnProjectHours projects, or the amount of FixedCostForProject projects.
+type ProjectRequest struct {
+	ProjectName string             `json:"project_name,omitempty"`
+	CustomerID  string             `json:"customer_id,omitempty"`
+	CurrencyID  string             `json:"currency_id,omitempty"`
+	Description string             `json:"description,omitempty"`
+	BillingType ProjectBillingType `json:"billing_type,omitempty"`
+	Rate        float64            `json:"rate,omitempty"`
+	// BudgetType is eg. "total_project_cost", "total_project_hours", "hours_per_task" or "hours_per_staff"
+	BudgetType       string        `json:"budget_type,omitempty"`
+	BudgetHours      string        `json:"budget_hours,omitempty"`
+	BudgetAmount     float64       `json:"budget_amount,omitempty"`
+	CostBudgetAmount float64       `json:"cost_budget_amount,omitempty"`
+	UserID           string        `json:"user_id,omitempty"`
+	Tasks            []TaskRequest `json:"tasks,omitempty"`
+	Users            []ProjectUser `json:"users,omitempty"`
+	CustomFields     []CustomField `json:"custom_fields,omitempty"`
+}
+
+// Project is a project of the organization, done for a customer
+type Project struct {
+	ProjectID     string             `json:"project_id,omitempty"`
+	ProjectName   string             `json:"project_name,omitempty"`
+	CustomerID    string             `json:"customer_id,omitempty"`
+	CustomerName  string             `json:"customer_name,omitempty"`
+	CurrencyID    string             `json:"currency_id,omitempty"`
+	CurrencyCode  string             `json:"currency_code,omitempty"`
+	Description   string             `json:"description,omitempty"`
+	Status        string             `json:"status,omitempty"`
+	BillingType   ProjectBillingType `json:"billing_type,omitempty"`
+	Rate          float64            `json:"rate,omitempty"`
+	BudgetType    string             `json:"budget_type,omitempty"`
+	BudgetHours   string             `json:"budget_hours,omitempty"`
+	BudgetAmount  float64            `json:"budget_amount,omitempty"`
+	TotalHours    string             `json:"total_hours,omitempty"`
+	BillableHours string             `json:"billable_hours,omitempty"`
+	BilledHours   string             `json:"billed_hours,omitempty"`
+	UnBilledHours string             `json:"un_billed_hours,omitempty"`
+	Tasks         []Task             `json:"tasks,omitempty"`
+	Users         []ProjectUser      `json:"users,omitempty"`
+	CustomFields  []CustomField      `json:"custom_fields,omitempty"`
+	CreatedTime   string             `json:"created_time,omitempty"`
+}
+
+// ProjectsResponse is the data returned by ListProjects
+type ProjectsResponse struct {
+	Response
+	Projects    []Project   `json:"projects,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// ProjectResponse is the data returned by GetProject, CreateProject, UpdateProject and CloneProject
+type ProjectResponse struct {
+	Response
+	Project Project `json:"project,omitempty"`
+}
+
+// ProjectUser is a user working on a project, Rate is the hourly rate of BasedOnStaffHours projects.
+// UserName and Email are only used to invite users to a project.
+type ProjectUser struct {
+	UserID        string  `json:"user_id,omitempty"`
+	UserName      string  `json:"user_name,omitempty"`
+	Email         string  `json:"email,omitempty"`
+	UserRole      string  `json:"user_role,omitempty"`
+	Status        string  `json:"status,omitempty"`
+	IsCurrentUser bool    `json:"is_current_user,omitempty"`
+	Rate          float64 `json:"rate,omitempty"`
+	BudgetHours   string  `json:"budget_hours,omitempty"`
+	CostRate      float64 `json:"cost_rate,omitempty"`
+	TotalHours    string  `json:"total_hours,omitempty"`
+	BillableHours string  `json:"billable_hours,omitempty"`
+}
+
+// AssignProjectUsersRequest is the data provided to AssignProjectUsers
+type AssignProjectUsersRequest struct {
+	Users []ProjectUser `json:"users"`
+}
+
+// ProjectUsersResponse is the data returned by ListProjectUsers and AssignProjectUsers
+type ProjectUsersResponse struct {
+	Response
+	Users []ProjectUser `json:"users,omitempty"`
+}
+
+// ProjectUserResponse is the data returned by GetProjectUser, InviteProjectUser and UpdateProjectUser
+type ProjectUserResponse struct {
+	Response
+	User ProjectUser `json:"user,omitempty"`
+}
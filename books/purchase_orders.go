@@ -1 +1,259 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListPurchaseOrders will return a page of the purchase orders of the organization, filtered and paged with params
+// (eg. 'vendor_id', 'status', 'date_start', 'date_end', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/purchase-order/#list-purchase-orders
+func (c *API) ListPurchaseOrders(params map[string]zoho.Parameter) (data PurchaseOrdersResponse, err error) {
+	endpoint := c.ListEndpoint(PurchaseOrdersModule, c.URL(PurchaseOrdersModule), &PurchaseOrdersResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list purchase orders"); err != nil {
+		return PurchaseOrdersResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*PurchaseOrdersResponse); ok {
+		return *v, nil
+	}
+
+	return PurchaseOrdersResponse{}, fmt.Errorf("Data retrieved was not 'PurchaseOrdersResponse'")
+}
+
+// GetPurchaseOrder will return the purchase order specified by id
+// https://www.zoho.com/books/api/v3/purchase-order/#get-a-purchase-order
+func (c *API) GetPurchaseOrder(id string) (data PurchaseOrderResponse, err error) {
+	endpoint := c.NewEndpoint(PurchaseOrdersModule, zoho.HTTPGet, c.URL("%s/%s", PurchaseOrdersModule, id), &PurchaseOrderResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve purchase order (%s)", id)); err != nil {
+		return PurchaseOrderResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*PurchaseOrderResponse); ok {
+		return *v, nil
+	}
+
+	return PurchaseOrderResponse{}, fmt.Errorf("Data retrieved was not 'PurchaseOrderResponse'")
+}
+
+// CreatePurchaseOrder will create the purchase order in request, VendorID and LineItems are required
+// https://www.zoho.com/books/api/v3/purchase-order/#create-a-purchase-order
+func (c *API) CreatePurchaseOrder(request PurchaseOrderRequest) (data PurchaseOrderResponse, err error) {
+	endpoint := c.NewEndpoint(PurchaseOrdersModule, zoho.HTTPPost, c.URL(PurchaseOrdersModule), &PurchaseOrderResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create purchase order"); err != nil {
+		return PurchaseOrderResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*PurchaseOrderResponse); ok {
+		return *v, nil
+	}
+
+	return PurchaseOrderResponse{}, fmt.Errorf("Data retrieved was not 'PurchaseOrderResponse'")
+}
+
+// UpdatePurchaseOrder will update the purchase order specified by id with request
+// https://www.zoho.com/books/api/v3/purchase-order/#update-a-purchase-order
+func (c *API) UpdatePurchaseOrder(request PurchaseOrderRequest, id string) (data PurchaseOrderResponse, err error) {
+	endpoint := c.NewEndpoint(PurchaseOrdersModule, zoho.HTTPPut, c.URL("%s/%s", PurchaseOrdersModule, id), &PurchaseOrderResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update purchase order (%s)", id)); err != nil {
+		return PurchaseOrderResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*PurchaseOrderResponse); ok {
+		return *v, nil
+	}
+
+	return PurchaseOrderResponse{}, fmt.Errorf("Data retrieved was not 'PurchaseOrderResponse'")
+}
+
+// DeletePurchaseOrder will delete the purchase order specified by id
+// https://www.zoho.com/books/api/v3/purchase-order/#delete-purchase-order
+func (c *API) DeletePurchaseOrder(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(PurchaseOrdersModule, zoho.HTTPDelete, c.URL("%s/%s", PurchaseOrdersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete purchase order (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkPurchaseOrderOpen will mark the draft purchase order specified by id as open
+// https://www.zoho.com/books/api/v3/purchase-order/#mark-a-purchase-order-as-open
+func (c *API) MarkPurchaseOrderOpen(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(PurchaseOrdersModule, zoho.HTTPPost, c.URL("%s/%s/status/open", PurchaseOrdersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark purchase order (%s) as open", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkPurchaseOrderIssued will mark the purchase order specified by id as issued to the vendor
+// https://www.zoho.com/books/api/v3/purchase-order/#mark-as-issued
+func (c *API) MarkPurchaseOrderIssued(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(PurchaseOrdersModule, zoho.HTTPPost, c.URL("%s/%s/status/issued", PurchaseOrdersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark purchase order (%s) as issued", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkPurchaseOrderBilled will mark the purchase order specified by id as billed
+// https://www.zoho.com/books/api/v3/purchase-order/#mark-as-billed
+func (c *API) MarkPurchaseOrderBilled(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(PurchaseOrdersModule, zoho.HTTPPost, c.URL("%s/%s/status/billed", PurchaseOrdersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark purchase order (%s) as billed", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// CancelPurchaseOrder will mark the purchase order specified by id as cancelled
+// https://www.zoho.com/books/api/v3/purchase-order/#mark-as-cancelled
+func (c *API) CancelPurchaseOrder(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(PurchaseOrdersModule, zoho.HTTPPost, c.URL("%s/%s/status/cancelled", PurchaseOrdersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark purchase order (%s) as cancelled", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// SubmitPurchaseOrder will submit the purchase order specified by id for approval
+// https://www.zoho.com/books/api/v3/purchase-order/#submit-a-purchase-order-for-approval
+func (c *API) SubmitPurchaseOrder(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(PurchaseOrdersModule, zoho.HTTPPost, c.URL("%s/%s/submit", PurchaseOrdersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to submit purchase order (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ApprovePurchaseOrder will approve the purchase order specified by id
+// https://www.zoho.com/books/api/v3/purchase-order/#approve-a-purchase-order
+func (c *API) ApprovePurchaseOrder(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(PurchaseOrdersModule, zoho.HTTPPost, c.URL("%s/%s/approve", PurchaseOrdersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to approve purchase order (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// PurchaseOrderRequest is the data provided to CreatePurchaseOrder and UpdatePurchaseOrder
+type PurchaseOrderRequest struct {
+	VendorID            string   `json:"vendor_id,omitempty"`
+	PurchaseOrderNumber string   `json:"purchaseorder_number,omitempty"`
+	ReferenceNumber     string   `json:"reference_number,omitempty"`
+	Date                string   `json:"date,omitempty"`
+	DeliveryDate        string   `json:"delivery_date,omitempty"`
+	CurrencyID          string   `json:"currency_id,omitempty"`
+	ExchangeRate        float64  `json:"exchange_rate,omitempty"`
+	IsInclusiveTax      bool     `json:"is_inclusive_tax,omitempty"`
+	ContactPersons      []string `json:"contact_persons,omitempty"`
+	// DeliveryCustomerID delivers the order to a customer rather than to the organization
+	DeliveryCustomerID string        `json:"delivery_customer_id,omitempty"`
+	Attention          string        `json:"attention,omitempty"`
+	ShipVia            string        `json:"ship_via,omitempty"`
+	LineItems          []LineItem    `json:"line_items,omitempty"`
+	Notes              string        `json:"notes,omitempty"`
+	Terms              string        `json:"terms,omitempty"`
+	TemplateID         string        `json:"template_id,omitempty"`
+	CustomFields       []CustomField `json:"custom_fields,omitempty"`
+}
+
+// PurchaseOrder is an order of goods or services sent to a vendor
+type PurchaseOrder struct {
+	PurchaseOrderID      string     `json:"purchaseorder_id,omitempty"`
+	PurchaseOrderNumber  string     `json:"purchaseorder_number,omitempty"`
+	Status               string     `json:"status,omitempty"`
+	BilledStatus         string     `json:"billed_status,omitempty"`
+	VendorID             string     `json:"vendor_id,omitempty"`
+	VendorName           string     `json:"vendor_name,omitempty"`
+	ReferenceNumber      string     `json:"reference_number,omitempty"`
+	Date                 string     `json:"date,omitempty"`
+	DeliveryDate         string     `json:"delivery_date,omitempty"`
+	ExpectedDeliveryDate string     `json:"expected_delivery_date,omitempty"`
+	CurrencyID           string     `json:"currency_id,omitempty"`
+	CurrencyCode         string     `json:"currency_code,omitempty"`
+	ExchangeRate         float64    `json:"exchange_rate,omitempty"`
+	IsInclusiveTax       bool       `json:"is_inclusive_tax,omitempty"`
+	LineItems            []LineItem `json:"line_items,omitempty"`
+	SubTotal             float64    `json:"sub_total,omitempty"`
+	TaxTotal             float64    `json:"tax_total,omitempty"`
+	Total                float64    `json:"total,omitempty"`
+	Taxes                []Tax      `json:"taxes,omitempty"`
+	Bills                []struct {
+		BillID     string  `json:"bill_id,omitempty"`
+		BillNumber string  `json:"bill_number,omitempty"`
+		Status     string  `json:"status,omitempty"`
+		Date       string  `json:"date,omitempty"`
+		Total      float64 `json:"total,omitempty"`
+		Balance    float64 `json:"balance,omitempty"`
+	} `json:"bills,omitempty"`
+	BillingAddress   Address       `json:"billing_address,omitempty"`
+	DeliveryAddress  Address       `json:"delivery_address,omitempty"`
+	Attention        string        `json:"attention,omitempty"`
+	ShipVia          string        `json:"ship_via,omitempty"`
+	Notes            string        `json:"notes,omitempty"`
+	Terms            string        `json:"terms,omitempty"`
+	CustomFields     []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime      string        `json:"created_time,omitempty"`
+	LastModifiedTime string        `json:"last_modified_time,omitempty"`
+}
+
+// PurchaseOrdersResponse is the data returned by ListPurchaseOrders
+type PurchaseOrdersResponse struct {
+	Response
+	PurchaseOrders []PurchaseOrder `json:"purchaseorders,omitempty"`
+	PageContext    PageContext     `json:"page_context,omitempty"`
+}
+
+// PurchaseOrderResponse is the data returned by GetPurchaseOrder, CreatePurchaseOrder and UpdatePurchaseOrder
+type PurchaseOrderResponse struct {
+	Response
+	PurchaseOrder PurchaseOrder `json:"purchaseorder,omitempty"`
+}
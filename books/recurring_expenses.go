@@ -1 +1,233 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListRecurringExpenses will return a page of the recurring expenses of the organization, filtered and paged with params
+// (eg. 'vendor_id', 'customer_id', 'status', 'recurrence_name', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/recurring-expenses/#list-recurring-expenses
+func (c *API) ListRecurringExpenses(params map[string]zoho.Parameter) (data RecurringExpensesResponse, err error) {
+	endpoint := c.ListEndpoint(RecurringExpensesModule, c.URL(RecurringExpensesModule), &RecurringExpensesResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list recurring expenses"); err != nil {
+		return RecurringExpensesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringExpensesResponse); ok {
+		return *v, nil
+	}
+
+	return RecurringExpensesResponse{}, fmt.Errorf("Data retrieved was not 'RecurringExpensesResponse'")
+}
+
+// GetRecurringExpense will return the recurring expense specified by id
+// https://www.zoho.com/books/api/v3/recurring-expenses/#get-a-recurring-expense
+func (c *API) GetRecurringExpense(id string) (data RecurringExpenseResponse, err error) {
+	endpoint := c.NewEndpoint(RecurringExpensesModule, zoho.HTTPGet, c.URL("%s/%s", RecurringExpensesModule, id), &RecurringExpenseResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve recurring expense (%s)", id)); err != nil {
+		return RecurringExpenseResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringExpenseResponse); ok {
+		return *v, nil
+	}
+
+	return RecurringExpenseResponse{}, fmt.Errorf("Data retrieved was not 'RecurringExpenseResponse'")
+}
+
+// CreateRecurringExpense will create the recurring expense in request, AccountID, PaidThroughAccountID, RecurrenceName,
+// StartDate, RecurrenceFrequency, RepeatEvery and Amount are required
+// https://www.zoho.com/books/api/v3/recurring-expenses/#create-a-recurring-expense
+func (c *API) CreateRecurringExpense(request RecurringExpenseRequest) (data RecurringExpenseResponse, err error) {
+	endpoint := c.NewEndpoint(RecurringExpensesModule, zoho.HTTPPost, c.URL(RecurringExpensesModule), &RecurringExpenseResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create recurring expense"); err != nil {
+		return RecurringExpenseResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringExpenseResponse); ok {
+		return *v, nil
+	}
+
+	return RecurringExpenseResponse{}, fmt.Errorf("Data retrieved was not 'RecurringExpenseResponse'")
+}
+
+// UpdateRecurringExpense will update the recurring expense specified by id with request
+// https://www.zoho.com/books/api/v3/recurring-expenses/#update-a-recurring-expense
+func (c *API) UpdateRecurringExpense(request RecurringExpenseRequest, id string) (data RecurringExpenseResponse, err error) {
+	endpoint := c.NewEndpoint(RecurringExpensesModule, zoho.HTTPPut, c.URL("%s/%s", RecurringExpensesModule, id), &RecurringExpenseResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update recurring expense (%s)", id)); err != nil {
+		return RecurringExpenseResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringExpenseResponse); ok {
+		return *v, nil
+	}
+
+	return RecurringExpenseResponse{}, fmt.Errorf("Data retrieved was not 'RecurringExpenseResponse'")
+}
+
+// DeleteRecurringExpense will delete the recurring expense specified by id
+// https://www.zoho.com/books/api/v3/recurring-expenses/#delete-a-recurring-expense
+func (c *API) DeleteRecurringExpense(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RecurringExpensesModule, zoho.HTTPDelete, c.URL("%s/%s", RecurringExpensesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete recurring expense (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// StopRecurringExpense will stop the active recurring expense specified by id, no more expenses are created
+// https://www.zoho.com/books/api/v3/recurring-expenses/#stop-a-recurring-expense
+func (c *API) StopRecurringExpense(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RecurringExpensesModule, zoho.HTTPPost, c.URL("%s/%s/status/stop", RecurringExpensesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to stop recurring expense (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ResumeRecurringExpense will resume the stopped recurring expense specified by id
+// https://www.zoho.com/books/api/v3/recurring-expenses/#resume-a-recurring-expense
+func (c *API) ResumeRecurringExpense(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RecurringExpensesModule, zoho.HTTPPost, c.URL("%s/%s/status/resume", RecurringExpensesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to resume recurring expense (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ListChildExpenses will return a page of the expenses created by the recurring expense specified by id, paged with params
+// https://www.zoho.com/books/api/v3/recurring-expenses/#list-child-expenses-created
+func (c *API) ListChildExpenses(id string, params map[string]zoho.Parameter) (data ChildExpensesResponse, err error) {
+	endpoint := c.ListEndpoint(RecurringExpensesModule, c.URL("%s/%s/expenses", RecurringExpensesModule, id), &ChildExpensesResponse{}, params)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list expenses of recurring expense (%s)", id)); err != nil {
+		return ChildExpensesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ChildExpensesResponse); ok {
+		return *v, nil
+	}
+
+	return ChildExpensesResponse{}, fmt.Errorf("Data retrieved was not 'ChildExpensesResponse'")
+}
+
+// ListRecurringExpenseHistory will return the history of the recurring expense specified by id
+// https://www.zoho.com/books/api/v3/recurring-expenses/#list-recurring-expense-history
+func (c *API) ListRecurringExpenseHistory(id string) (data HistoryResponse, err error) {
+	endpoint := c.NewEndpoint(RecurringExpensesModule, zoho.HTTPGet, c.URL("%s/%s/comments", RecurringExpensesModule, id), &HistoryResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list history of recurring expense (%s)", id)); err != nil {
+		return HistoryResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*HistoryResponse); ok {
+		return *v, nil
+	}
+
+	return HistoryResponse{}, fmt.Errorf("Data retrieved was not 'HistoryResponse'")
+}
+
+// RecurringExpenseRequest is the data provided to CreateRecurringExpense and UpdateRecurringExpense.
+// AccountID is the expense account and PaidThroughAccountID the account the expense is paid from.
+type RecurringExpenseRequest struct {
+	Recurrence
+	AccountID            string  `json:"account_id,omitempty"`
+	PaidThroughAccountID string  `json:"paid_through_account_id,omitempty"`
+	Amount               float64 `json:"amount,omitempty"`
+	VendorID             string  `json:"vendor_id,omitempty"`
+	// CustomerID and ProjectID are the customer and project billable expenses are charged to
+	CustomerID     string        `json:"customer_id,omitempty"`
+	ProjectID      string        `json:"project_id,omitempty"`
+	IsBillable     bool          `json:"is_billable,omitempty"`
+	Description    string        `json:"description,omitempty"`
+	CurrencyID     string        `json:"currency_id,omitempty"`
+	ExchangeRate   float64       `json:"exchange_rate,omitempty"`
+	TaxID          string        `json:"tax_id,omitempty"`
+	IsInclusiveTax bool          `json:"is_inclusive_tax,omitempty"`
+	CustomFields   []CustomField `json:"custom_fields,omitempty"`
+}
+
+// RecurringExpense is the template of the expenses recorded on a schedule
+type RecurringExpense struct {
+	Recurrence
+	RecurringExpenseID     string        `json:"recurring_expense_id,omitempty"`
+	Status                 string        `json:"status,omitempty"`
+	AccountID              string        `json:"account_id,omitempty"`
+	AccountName            string        `json:"account_name,omitempty"`
+	PaidThroughAccountID   string        `json:"paid_through_account_id,omitempty"`
+	PaidThroughAccountName string        `json:"paid_through_account_name,omitempty"`
+	VendorID               string        `json:"vendor_id,omitempty"`
+	VendorName             string        `json:"vendor_name,omitempty"`
+	CustomerID             string        `json:"customer_id,omitempty"`
+	CustomerName           string        `json:"customer_name,omitempty"`
+	ProjectID              string        `json:"project_id,omitempty"`
+	IsBillable             bool          `json:"is_billable,omitempty"`
+	Description            string        `json:"description,omitempty"`
+	CurrencyID             string        `json:"currency_id,omitempty"`
+	CurrencyCode           string        `json:"currency_code,omitempty"`
+	ExchangeRate           float64       `json:"exchange_rate,omitempty"`
+	TaxID                  string        `json:"tax_id,omitempty"`
+	IsInclusiveTax         bool          `json:"is_inclusive_tax,omitempty"`
+	Amount                 float64       `json:"amount,omitempty"`
+	SubTotal               float64       `json:"sub_total,omitempty"`
+	Total                  float64       `json:"total,omitempty"`
+	LastCreatedDate        string        `json:"last_created_date,omitempty"`
+	NextExpenseDate        string        `json:"next_expense_date,omitempty"`
+	CustomFields           []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime            string        `json:"created_time,omitempty"`
+	LastModifiedTime       string        `json:"last_modified_time,omitempty"`
+}
+
+// RecurringExpensesResponse is the data returned by ListRecurringExpenses
+type RecurringExpensesResponse struct {
+	Response
+	RecurringExpenses []RecurringExpense `json:"recurring_expenses,omitempty"`
+	PageContext       PageContext        `json:"page_context,omitempty"`
+}
+
+// RecurringExpenseResponse is the data returned by GetRecurringExpense, CreateRecurringExpense and UpdateRecurringExpense
+type RecurringExpenseResponse struct {
+	Response
+	RecurringExpense RecurringExpense `json:"recurring_expense,omitempty"`
+}
+
+// ChildExpensesResponse is the data returned by ListChildExpenses
+type ChildExpensesResponse struct {
+	Response
+	Expenses []struct {
+		ExpenseID              string  `json:"expense_id,omitempty"`
+		Date                   string  `json:"date,omitempty"`
+		AccountName            string  `json:"account_name,omitempty"`
+		VendorName             string  `json:"vendor_name,omitempty"`
+		PaidThroughAccountName string  `json:"paid_through_account_name,omitempty"`
+		CustomerName           string  `json:"customer_name,omitempty"`
+		Total                  float64 `json:"total,omitempty"`
+		Status                 string  `json:"status,omitempty"`
+	} `json:"expensehistory,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
@@ -1 +1,249 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListRecurringInvoices will return a page of the recurring invoices of the organization, filtered and paged with params
+// (eg. 'customer_id', 'status', 'recurrence_name', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/recurring-invoices/#list-all-recurring-invoice
+func (c *API) ListRecurringInvoices(params map[string]zoho.Parameter) (data RecurringInvoicesResponse, err error) {
+	endpoint := c.ListEndpoint(RecurringInvoicesModule, c.URL(RecurringInvoicesModule), &RecurringInvoicesResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list recurring invoices"); err != nil {
+		return RecurringInvoicesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringInvoicesResponse); ok {
+		return *v, nil
+	}
+
+	return RecurringInvoicesResponse{}, fmt.Errorf("Data retrieved was not 'RecurringInvoicesResponse'")
+}
+
+// GetRecurringInvoice will return the recurring invoice specified by id
+// https://www.zoho.com/books/api/v3/recurring-invoices/#get-a-recurring-invoice
+func (c *API) GetRecurringInvoice(id string) (data RecurringInvoiceResponse, err error) {
+	endpoint := c.NewEndpoint(RecurringInvoicesModule, zoho.HTTPGet, c.URL("%s/%s", RecurringInvoicesModule, id), &RecurringInvoiceResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve recurring invoice (%s)", id)); err != nil {
+		return RecurringInvoiceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringInvoiceResponse); ok {
+		return *v, nil
+	}
+
+	return RecurringInvoiceResponse{}, fmt.Errorf("Data retrieved was not 'RecurringInvoiceResponse'")
+}
+
+// CreateRecurringInvoice will create the recurring invoice in request, CustomerID, RecurrenceName, StartDate,
+// RecurrenceFrequency, RepeatEvery and LineItems are required
+// https://www.zoho.com/books/api/v3/recurring-invoices/#create-a-recurring-invoice
+func (c *API) CreateRecurringInvoice(request RecurringInvoiceRequest) (data RecurringInvoiceResponse, err error) {
+	endpoint := c.NewEndpoint(RecurringInvoicesModule, zoho.HTTPPost, c.URL(RecurringInvoicesModule), &RecurringInvoiceResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create recurring invoice"); err != nil {
+		return RecurringInvoiceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringInvoiceResponse); ok {
+		return *v, nil
+	}
+
+	return RecurringInvoiceResponse{}, fmt.Errorf("Data retrieved was not 'RecurringInvoiceResponse'")
+}
+
+// UpdateRecurringInvoice will update the recurring invoice specified by id with request
+// https://www.zoho.com/books/api/v3/recurring-invoices/#update-recurring-invoice
+func (c *API) UpdateRecurringInvoice(request RecurringInvoiceRequest, id string) (data RecurringInvoiceResponse, err error) {
+	endpoint := c.NewEndpoint(RecurringInvoicesModule, zoho.HTTPPut, c.URL("%s/%s", RecurringInvoicesModule, id), &RecurringInvoiceResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update recurring invoice (%s)", id)); err != nil {
+		return RecurringInvoiceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringInvoiceResponse); ok {
+		return *v, nil
+	}
+
+	return RecurringInvoiceResponse{}, fmt.Errorf("Data retrieved was not 'RecurringInvoiceResponse'")
+}
+
+// DeleteRecurringInvoice will delete the recurring invoice specified by id
+// https://www.zoho.com/books/api/v3/recurring-invoices/#delete-a-recurring-invoice
+func (c *API) DeleteRecurringInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RecurringInvoicesModule, zoho.HTTPDelete, c.URL("%s/%s", RecurringInvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete recurring invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// StopRecurringInvoice will stop the active recurring invoice specified by id, no more invoices are created
+// https://www.zoho.com/books/api/v3/recurring-invoices/#stop-a-recurring-invoice
+func (c *API) StopRecurringInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RecurringInvoicesModule, zoho.HTTPPost, c.URL("%s/%s/status/stop", RecurringInvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to stop recurring invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ResumeRecurringInvoice will resume the stopped recurring invoice specified by id
+// https://www.zoho.com/books/api/v3/recurring-invoices/#resume-a-recurring-invoice
+func (c *API) ResumeRecurringInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RecurringInvoicesModule, zoho.HTTPPost, c.URL("%s/%s/status/resume", RecurringInvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to resume recurring invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ListChildInvoices will return a page of the invoices created by the recurring invoice specified by id,
+// filtered and paged with params (eg. 'status', 'date_start', 'date_end', 'page')
+// https://www.zoho.com/books/api/v3/invoices/#list-invoices
+func (c *API) ListChildInvoices(id string, params map[string]zoho.Parameter) (data InvoicesResponse, err error) {
+	p := map[string]zoho.Parameter{"recurring_invoice_id": zoho.Parameter(id)}
+	for k, v := range params {
+		p[k] = v
+	}
+	return c.ListInvoices(p)
+}
+
+// ListRecurringInvoiceHistory will return the history of the recurring invoice specified by id,
+// the changes made to it and the invoices it created
+// https://www.zoho.com/books/api/v3/recurring-invoices/#list-recurring-invoice-history
+func (c *API) ListRecurringInvoiceHistory(id string) (data HistoryResponse, err error) {
+	endpoint := c.NewEndpoint(RecurringInvoicesModule, zoho.HTTPGet, c.URL("%s/%s/comments", RecurringInvoicesModule, id), &HistoryResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list history of recurring invoice (%s)", id)); err != nil {
+		return HistoryResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*HistoryResponse); ok {
+		return *v, nil
+	}
+
+	return HistoryResponse{}, fmt.Errorf("Data retrieved was not 'HistoryResponse'")
+}
+
+// Recurrence is the schedule of a recurring transaction, RecurrenceFrequency is "days", "weeks", "months" or "years"
+// and RepeatEvery the number of these between two transactions. An empty EndDate never ends the recurrence.
+type Recurrence struct {
+	RecurrenceName      string `json:"recurrence_name,omitempty"`
+	StartDate           string `json:"start_date,omitempty"`
+	EndDate             string `json:"end_date,omitempty"`
+	RecurrenceFrequency string `json:"recurrence_frequency,omitempty"`
+	RepeatEvery         int    `json:"repeat_every,omitempty"`
+}
+
+// RecurringInvoiceRequest is the data provided to CreateRecurringInvoice and UpdateRecurringInvoice
+type RecurringInvoiceRequest struct {
+	Recurrence
+	CustomerID            string          `json:"customer_id,omitempty"`
+	ContactPersons        []string        `json:"contact_persons,omitempty"`
+	ReferenceNumber       string          `json:"reference_number,omitempty"`
+	TemplateID            string          `json:"template_id,omitempty"`
+	PaymentTerms          int             `json:"payment_terms,omitempty"`
+	PaymentTermsLabel     string          `json:"payment_terms_label,omitempty"`
+	CurrencyID            string          `json:"currency_id,omitempty"`
+	ExchangeRate          float64         `json:"exchange_rate,omitempty"`
+	Discount              interface{}     `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool            `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string          `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool            `json:"is_inclusive_tax,omitempty"`
+	SalespersonName       string          `json:"salesperson_name,omitempty"`
+	LineItems             []LineItem      `json:"line_items,omitempty"`
+	PaymentOptions        *PaymentOptions `json:"payment_options,omitempty"`
+	AllowPartialPayments  bool            `json:"allow_partial_payments,omitempty"`
+	Notes                 string          `json:"notes,omitempty"`
+	Terms                 string          `json:"terms,omitempty"`
+	ShippingCharge        float64         `json:"shipping_charge,omitempty"`
+	Adjustment            float64         `json:"adjustment,omitempty"`
+	AdjustmentDescription string          `json:"adjustment_description,omitempty"`
+	TaxAuthorityID        string          `json:"tax_authority_id,omitempty"`
+	TaxExemptionID        string          `json:"tax_exemption_id,omitempty"`
+	CustomFields          []CustomField   `json:"custom_fields,omitempty"`
+}
+
+// RecurringInvoice is the template of the invoices created on a schedule for a customer
+type RecurringInvoice struct {
+	Recurrence
+	RecurringInvoiceID string        `json:"recurring_invoice_id,omitempty"`
+	Status             string        `json:"status,omitempty"`
+	CustomerID         string        `json:"customer_id,omitempty"`
+	CustomerName       string        `json:"customer_name,omitempty"`
+	ReferenceNumber    string        `json:"reference_number,omitempty"`
+	LastSentDate       string        `json:"last_sent_date,omitempty"`
+	NextInvoiceDate    string        `json:"next_invoice_date,omitempty"`
+	PaymentTerms       int           `json:"payment_terms,omitempty"`
+	PaymentTermsLabel  string        `json:"payment_terms_label,omitempty"`
+	CurrencyID         string        `json:"currency_id,omitempty"`
+	CurrencyCode       string        `json:"currency_code,omitempty"`
+	ExchangeRate       float64       `json:"exchange_rate,omitempty"`
+	IsInclusiveTax     bool          `json:"is_inclusive_tax,omitempty"`
+	LineItems          []LineItem    `json:"line_items,omitempty"`
+	SubTotal           float64       `json:"sub_total,omitempty"`
+	TaxTotal           float64       `json:"tax_total,omitempty"`
+	Total              float64       `json:"total,omitempty"`
+	Taxes              []Tax         `json:"taxes,omitempty"`
+	BillingAddress     Address       `json:"billing_address,omitempty"`
+	ShippingAddress    Address       `json:"shipping_address,omitempty"`
+	Notes              string        `json:"notes,omitempty"`
+	Terms              string        `json:"terms,omitempty"`
+	CustomFields       []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime        string        `json:"created_time,omitempty"`
+	LastModifiedTime   string        `json:"last_modified_time,omitempty"`
+}
+
+// RecurringInvoicesResponse is the data returned by ListRecurringInvoices
+type RecurringInvoicesResponse struct {
+	Response
+	RecurringInvoices []RecurringInvoice `json:"recurring_invoices,omitempty"`
+	PageContext       PageContext        `json:"page_context,omitempty"`
+}
+
+// RecurringInvoiceResponse is the data returned by GetRecurringInvoice, CreateRecurringInvoice and UpdateRecurringInvoice
+type RecurringInvoiceResponse struct {
+	Response
+	RecurringInvoice RecurringInvoice `json:"recurring_invoice,omitempty"`
+}
+
+// HistoryResponse is the data returned when listing the history of a transaction
+type HistoryResponse struct {
+	Response
+	Comments []struct {
+		CommentID       string `json:"comment_id,omitempty"`
+		Description     string `json:"description,omitempty"`
+		CommentedByID   string `json:"commented_by_id,omitempty"`
+		CommentedBy     string `json:"commented_by,omitempty"`
+		CommentType     string `json:"comment_type,omitempty"`
+		Date            string `json:"date,omitempty"`
+		DateDescription string `json:"date_description,omitempty"`
+		Time            string `json:"time,omitempty"`
+		OperationType   string `json:"operation_type,omitempty"`
+		TransactionID   string `json:"transaction_id,omitempty"`
+		TransactionType string `json:"transaction_type,omitempty"`
+	} `json:"comments,omitempty"`
+}
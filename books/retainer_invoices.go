@@ -1 +1,298 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListRetainerInvoices will return a page of the retainer invoices of the organization, filtered and paged with params
+// (eg. 'customer_id', 'filter_by', 'sort_column', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/retainer-invoices/#list-a-retainer-invoices
+func (c *API) ListRetainerInvoices(params map[string]zoho.Parameter) (data RetainerInvoicesResponse, err error) {
+	endpoint := c.ListEndpoint(RetainerInvoicesModule, c.URL(RetainerInvoicesModule), &RetainerInvoicesResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list retainer invoices"); err != nil {
+		return RetainerInvoicesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RetainerInvoicesResponse); ok {
+		return *v, nil
+	}
+
+	return RetainerInvoicesResponse{}, fmt.Errorf("Data retrieved was not 'RetainerInvoicesResponse'")
+}
+
+// GetRetainerInvoice will return the retainer invoice specified by id
+// https://www.zoho.com/books/api/v3/retainer-invoices/#get-a-retainer-invoice
+func (c *API) GetRetainerInvoice(id string) (data RetainerInvoiceResponse, err error) {
+	endpoint := c.NewEndpoint(RetainerInvoicesModule, zoho.HTTPGet, c.URL("%s/%s", RetainerInvoicesModule, id), &RetainerInvoiceResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve retainer invoice (%s)", id)); err != nil {
+		return RetainerInvoiceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RetainerInvoiceResponse); ok {
+		return *v, nil
+	}
+
+	return RetainerInvoiceResponse{}, fmt.Errorf("Data retrieved was not 'RetainerInvoiceResponse'")
+}
+
+// CreateRetainerInvoice will create the retainer invoice in request, CustomerID and LineItems are required
+// https://www.zoho.com/books/api/v3/retainer-invoices/#create-a-retainerinvoice
+func (c *API) CreateRetainerInvoice(request RetainerInvoiceRequest) (data RetainerInvoiceResponse, err error) {
+	endpoint := c.NewEndpoint(RetainerInvoicesModule, zoho.HTTPPost, c.URL(RetainerInvoicesModule), &RetainerInvoiceResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create retainer invoice"); err != nil {
+		return RetainerInvoiceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RetainerInvoiceResponse); ok {
+		return *v, nil
+	}
+
+	return RetainerInvoiceResponse{}, fmt.Errorf("Data retrieved was not 'RetainerInvoiceResponse'")
+}
+
+// UpdateRetainerInvoice will update the retainer invoice specified by id with request
+// https://www.zoho.com/books/api/v3/retainer-invoices/#update-a-retainerinvoice
+func (c *API) UpdateRetainerInvoice(request RetainerInvoiceRequest, id string) (data RetainerInvoiceResponse, err error) {
+	endpoint := c.NewEndpoint(RetainerInvoicesModule, zoho.HTTPPut, c.URL("%s/%s", RetainerInvoicesModule, id), &RetainerInvoiceResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update retainer invoice (%s)", id)); err != nil {
+		return RetainerInvoiceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*RetainerInvoiceResponse); ok {
+		return *v, nil
+	}
+
+	return RetainerInvoiceResponse{}, fmt.Errorf("Data retrieved was not 'RetainerInvoiceResponse'")
+}
+
+// DeleteRetainerInvoice will delete the retainer invoice specified by id
+// https://www.zoho.com/books/api/v3/retainer-invoices/#delete-a-retainer-invoice
+func (c *API) DeleteRetainerInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RetainerInvoicesModule, zoho.HTTPDelete, c.URL("%s/%s", RetainerInvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete retainer invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkRetainerInvoiceSent will mark the draft retainer invoice specified by id as sent
+// https://www.zoho.com/books/api/v3/retainer-invoices/#mark-a-retainer-invoice-as-sent
+func (c *API) MarkRetainerInvoiceSent(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RetainerInvoicesModule, zoho.HTTPPost, c.URL("%s/%s/status/sent", RetainerInvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark retainer invoice (%s) as sent", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// VoidRetainerInvoice will mark the retainer invoice specified by id as void
+// https://www.zoho.com/books/api/v3/retainer-invoices/#void-a-retainer-invoice
+func (c *API) VoidRetainerInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RetainerInvoicesModule, zoho.HTTPPost, c.URL("%s/%s/status/void", RetainerInvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark retainer invoice (%s) as void", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkRetainerInvoiceDraft will mark the void retainer invoice specified by id as draft
+// https://www.zoho.com/books/api/v3/retainer-invoices/#mark-as-draft
+func (c *API) MarkRetainerInvoiceDraft(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RetainerInvoicesModule, zoho.HTTPPost, c.URL("%s/%s/status/draft", RetainerInvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark retainer invoice (%s) as draft", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// SubmitRetainerInvoice will submit the retainer invoice specified by id for approval
+// https://www.zoho.com/books/api/v3/retainer-invoices/#submit-a-retainer-invoice-for-approval
+func (c *API) SubmitRetainerInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RetainerInvoicesModule, zoho.HTTPPost, c.URL("%s/%s/submit", RetainerInvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to submit retainer invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ApproveRetainerInvoice will approve the retainer invoice specified by id
+// https://www.zoho.com/books/api/v3/retainer-invoices/#approve-a-retainer-invoice
+func (c *API) ApproveRetainerInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RetainerInvoicesModule, zoho.HTTPPost, c.URL("%s/%s/approve", RetainerInvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to approve retainer invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// EmailRetainerInvoice will email the retainer invoice specified by id as described by request
+// https://www.zoho.com/books/api/v3/retainer-invoices/#email-a-retainer-invoice
+func (c *API) EmailRetainerInvoice(request EmailRequest, id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(RetainerInvoicesModule, zoho.HTTPPost, c.URL("%s/%s/email", RetainerInvoicesModule, id), &Response{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to email retainer invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ApplyRetainerInvoice applies the unused payments of the paid retainer invoice retainerInvoiceID to the invoice invoiceID
+// of the same customer, up to amount. An amount of 0 applies every unused payment of the retainer, up to the balance of
+// the invoice. The amount applied is returned.
+// https://www.zoho.com/books/api/v3/invoices/#apply-credits
+func (c *API) ApplyRetainerInvoice(retainerInvoiceID string, invoiceID string, amount float64) (applied float64, err error) {
+	retainer, err := c.GetRetainerInvoice(retainerInvoiceID)
+	if err != nil {
+		return 0, err
+	}
+
+	if amount <= 0 {
+		invoice, err := c.GetInvoice(invoiceID)
+		if err != nil {
+			return 0, err
+		}
+		amount = invoice.Invoice.Balance
+	}
+
+	request := InvoiceCreditsRequest{}
+	remaining := cents(amount)
+	for _, p := range retainer.RetainerInvoice.Payments {
+		if remaining <= 0 {
+			break
+		}
+		payment, err := c.GetCustomerPayment(p.PaymentID)
+		if err != nil {
+			return 0, err
+		}
+		unused := cents(payment.Payment.UnusedAmount)
+		if unused <= 0 {
+			continue
+		}
+		if unused > remaining {
+			unused = remaining
+		}
+		request.InvoicePayments = append(request.InvoicePayments, AppliedPayment{PaymentID: p.PaymentID, AmountApplied: float64(unused) / 100})
+		remaining -= unused
+	}
+	if len(request.InvoicePayments) == 0 {
+		return 0, fmt.Errorf("Failed to apply retainer invoice (%s): no unused payments", retainerInvoiceID)
+	}
+
+	if _, err = c.ApplyCreditsToInvoice(request, invoiceID); err != nil {
+		return 0, err
+	}
+	return float64(cents(amount)-remaining) / 100, nil
+}
+
+// RetainerInvoiceRequest is the data provided to CreateRetainerInvoice and UpdateRetainerInvoice
+type RetainerInvoiceRequest struct {
+	CustomerID      string   `json:"customer_id,omitempty"`
+	ContactPersons  []string `json:"contact_persons,omitempty"`
+	ReferenceNumber string   `json:"reference_number,omitempty"`
+	Date            string   `json:"date,omitempty"`
+	CurrencyID      string   `json:"currency_id,omitempty"`
+	ExchangeRate    float64  `json:"exchange_rate,omitempty"`
+	TemplateID      string   `json:"template_id,omitempty"`
+	// ProjectID or EstimateID are the project or estimate the retainer is collected for
+	ProjectID      string          `json:"project_id,omitempty"`
+	EstimateID     string          `json:"estimate_id,omitempty"`
+	LineItems      []LineItem      `json:"line_items,omitempty"`
+	PaymentOptions *PaymentOptions `json:"payment_options,omitempty"`
+	Notes          string          `json:"notes,omitempty"`
+	Terms          string          `json:"terms,omitempty"`
+	CustomFields   []CustomField   `json:"custom_fields,omitempty"`
+}
+
+// RetainerInvoice is an invoice collecting an advance payment from a customer, its payments are applied to
+// the invoices of the customer later on
+type RetainerInvoice struct {
+	RetainerInvoiceID     string     `json:"retainerinvoice_id,omitempty"`
+	RetainerInvoiceNumber string     `json:"retainerinvoice_number,omitempty"`
+	Status                string     `json:"status,omitempty"`
+	CustomerID            string     `json:"customer_id,omitempty"`
+	CustomerName          string     `json:"customer_name,omitempty"`
+	ReferenceNumber       string     `json:"reference_number,omitempty"`
+	Date                  string     `json:"date,omitempty"`
+	ProjectID             string     `json:"project_id,omitempty"`
+	EstimateID            string     `json:"estimate_id,omitempty"`
+	CurrencyID            string     `json:"currency_id,omitempty"`
+	CurrencyCode          string     `json:"currency_code,omitempty"`
+	ExchangeRate          float64    `json:"exchange_rate,omitempty"`
+	LineItems             []LineItem `json:"line_items,omitempty"`
+	Total                 float64    `json:"total,omitempty"`
+	PaymentMade           float64    `json:"payment_made,omitempty"`
+	Balance               float64    `json:"balance,omitempty"`
+	Payments              []struct {
+		PaymentID       string  `json:"payment_id,omitempty"`
+		PaymentMode     string  `json:"payment_mode,omitempty"`
+		Date            string  `json:"date,omitempty"`
+		Amount          float64 `json:"amount,omitempty"`
+		ReferenceNumber string  `json:"reference_number,omitempty"`
+	} `json:"payments,omitempty"`
+	BillingAddress   Address       `json:"billing_address,omitempty"`
+	Notes            string        `json:"notes,omitempty"`
+	Terms            string        `json:"terms,omitempty"`
+	CustomFields     []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime      string        `json:"created_time,omitempty"`
+	LastModifiedTime string        `json:"last_modified_time,omitempty"`
+}
+
+// RetainerInvoicesResponse is the data returned by ListRetainerInvoices
+type RetainerInvoicesResponse struct {
+	Response
+	RetainerInvoices []RetainerInvoice `json:"retainerinvoices,omitempty"`
+	PageContext      PageContext       `json:"page_context,omitempty"`
+}
+
+// RetainerInvoiceResponse is the data returned by GetRetainerInvoice, CreateRetainerInvoice and UpdateRetainerInvoice
+type RetainerInvoiceResponse struct {
+	Response
+	RetainerInvoice RetainerInvoice `json:"retainerinvoice,omitempty"`
+}
@@ -1 +1,206 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListSalesOrders will return a page of the sales orders of the organization, filtered and paged with params
+// (eg. 'customer_id', 'status', 'date_start', 'date_end', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/sales-order/#list-sales-orders
+func (c *API) ListSalesOrders(params map[string]zoho.Parameter) (data SalesOrdersResponse, err error) {
+	endpoint := c.ListEndpoint(SalesOrdersModule, c.URL(SalesOrdersModule), &SalesOrdersResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list sales orders"); err != nil {
+		return SalesOrdersResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*SalesOrdersResponse); ok {
+		return *v, nil
+	}
+
+	return SalesOrdersResponse{}, fmt.Errorf("Data retrieved was not 'SalesOrdersResponse'")
+}
+
+// GetSalesOrder will return the sales order specified by id
+// https://www.zoho.com/books/api/v3/sales-order/#get-a-sales-order
+func (c *API) GetSalesOrder(id string) (data SalesOrderResponse, err error) {
+	endpoint := c.NewEndpoint(SalesOrdersModule, zoho.HTTPGet, c.URL("%s/%s", SalesOrdersModule, id), &SalesOrderResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve sales order (%s)", id)); err != nil {
+		return SalesOrderResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*SalesOrderResponse); ok {
+		return *v, nil
+	}
+
+	return SalesOrderResponse{}, fmt.Errorf("Data retrieved was not 'SalesOrderResponse'")
+}
+
+// CreateSalesOrder will create the sales order in request, CustomerID and LineItems are required
+// https://www.zoho.com/books/api/v3/sales-order/#create-a-sales-order
+func (c *API) CreateSalesOrder(request SalesOrderRequest) (data SalesOrderResponse, err error) {
+	endpoint := c.NewEndpoint(SalesOrdersModule, zoho.HTTPPost, c.URL(SalesOrdersModule), &SalesOrderResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create sales order"); err != nil {
+		return SalesOrderResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*SalesOrderResponse); ok {
+		return *v, nil
+	}
+
+	return SalesOrderResponse{}, fmt.Errorf("Data retrieved was not 'SalesOrderResponse'")
+}
+
+// UpdateSalesOrder will update the sales order specified by id with request
+// https://www.zoho.com/books/api/v3/sales-order/#update-a-sales-order
+func (c *API) UpdateSalesOrder(request SalesOrderRequest, id string) (data SalesOrderResponse, err error) {
+	endpoint := c.NewEndpoint(SalesOrdersModule, zoho.HTTPPut, c.URL("%s/%s", SalesOrdersModule, id), &SalesOrderResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update sales order (%s)", id)); err != nil {
+		return SalesOrderResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*SalesOrderResponse); ok {
+		return *v, nil
+	}
+
+	return SalesOrderResponse{}, fmt.Errorf("Data retrieved was not 'SalesOrderResponse'")
+}
+
+// DeleteSalesOrder will delete the sales order specified by id
+// https://www.zoho.com/books/api/v3/sales-order/#delete-a-sales-order
+func (c *API) DeleteSalesOrder(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(SalesOrdersModule, zoho.HTTPDelete, c.URL("%s/%s", SalesOrdersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete sales order (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkSalesOrderOpen will mark the sales order specified by id as open
+// https://www.zoho.com/books/api/v3/sales-order/#mark-as-open
+func (c *API) MarkSalesOrderOpen(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(SalesOrdersModule, zoho.HTTPPost, c.URL("%s/%s/status/open", SalesOrdersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark sales order (%s) as open", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// VoidSalesOrder will mark the sales order specified by id as void
+// https://www.zoho.com/books/api/v3/sales-order/#mark-as-void
+func (c *API) VoidSalesOrder(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(SalesOrdersModule, zoho.HTTPPost, c.URL("%s/%s/status/void", SalesOrdersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark sales order (%s) as void", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// SalesOrderRequest is the data provided to CreateSalesOrder and UpdateSalesOrder
+type SalesOrderRequest struct {
+	CustomerID            string        `json:"customer_id,omitempty"`
+	ContactPersons        []string      `json:"contact_persons,omitempty"`
+	SalesOrderNumber      string        `json:"salesorder_number,omitempty"`
+	ReferenceNumber       string        `json:"reference_number,omitempty"`
+	TemplateID            string        `json:"template_id,omitempty"`
+	Date                  string        `json:"date,omitempty"`
+	ShipmentDate          string        `json:"shipment_date,omitempty"`
+	PaymentTerms          int           `json:"payment_terms,omitempty"`
+	PaymentTermsLabel     string        `json:"payment_terms_label,omitempty"`
+	DeliveryMethod        string        `json:"delivery_method,omitempty"`
+	CurrencyID            string        `json:"currency_id,omitempty"`
+	ExchangeRate          float64       `json:"exchange_rate,omitempty"`
+	Discount              interface{}   `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool          `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string        `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool          `json:"is_inclusive_tax,omitempty"`
+	SalespersonName       string        `json:"salesperson_name,omitempty"`
+	LineItems             []LineItem    `json:"line_items,omitempty"`
+	Notes                 string        `json:"notes,omitempty"`
+	Terms                 string        `json:"terms,omitempty"`
+	ShippingCharge        float64       `json:"shipping_charge,omitempty"`
+	Adjustment            float64       `json:"adjustment,omitempty"`
+	AdjustmentDescription string        `json:"adjustment_description,omitempty"`
+	BillingAddressID      string        `json:"billing_address_id,omitempty"`
+	ShippingAddressID     string        `json:"shipping_address_id,omitempty"`
+	CustomFields          []CustomField `json:"custom_fields,omitempty"`
+}
+
+// SalesOrder is an order confirmed by a customer
+type SalesOrder struct {
+	SalesOrderID          string        `json:"salesorder_id,omitempty"`
+	SalesOrderNumber      string        `json:"salesorder_number,omitempty"`
+	Status                string        `json:"status,omitempty"`
+	InvoicedStatus        string        `json:"invoiced_status,omitempty"`
+	PaidStatus            string        `json:"paid_status,omitempty"`
+	CustomerID            string        `json:"customer_id,omitempty"`
+	CustomerName          string        `json:"customer_name,omitempty"`
+	ContactPersons        []string      `json:"contact_persons,omitempty"`
+	ReferenceNumber       string        `json:"reference_number,omitempty"`
+	TemplateID            string        `json:"template_id,omitempty"`
+	Date                  string        `json:"date,omitempty"`
+	ShipmentDate          string        `json:"shipment_date,omitempty"`
+	PaymentTerms          int           `json:"payment_terms,omitempty"`
+	PaymentTermsLabel     string        `json:"payment_terms_label,omitempty"`
+	DeliveryMethod        string        `json:"delivery_method,omitempty"`
+	CurrencyID            string        `json:"currency_id,omitempty"`
+	CurrencyCode          string        `json:"currency_code,omitempty"`
+	ExchangeRate          float64       `json:"exchange_rate,omitempty"`
+	Discount              float64       `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool          `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string        `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool          `json:"is_inclusive_tax,omitempty"`
+	SalespersonID         string        `json:"salesperson_id,omitempty"`
+	SalespersonName       string        `json:"salesperson_name,omitempty"`
+	LineItems             []LineItem    `json:"line_items,omitempty"`
+	ShippingCharge        float64       `json:"shipping_charge,omitempty"`
+	Adjustment            float64       `json:"adjustment,omitempty"`
+	AdjustmentDescription string        `json:"adjustment_description,omitempty"`
+	SubTotal              float64       `json:"sub_total,omitempty"`
+	TaxTotal              float64       `json:"tax_total,omitempty"`
+	Total                 float64       `json:"total,omitempty"`
+	Taxes                 []Tax         `json:"taxes,omitempty"`
+	BillingAddress        Address       `json:"billing_address,omitempty"`
+	ShippingAddress       Address       `json:"shipping_address,omitempty"`
+	Notes                 string        `json:"notes,omitempty"`
+	Terms                 string        `json:"terms,omitempty"`
+	CustomFields          []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime           string        `json:"created_time,omitempty"`
+	LastModifiedTime      string        `json:"last_modified_time,omitempty"`
+}
+
+// SalesOrdersResponse is the data returned by ListSalesOrders
+type SalesOrdersResponse struct {
+	Response
+	SalesOrders []SalesOrder `json:"salesorders,omitempty"`
+	PageContext PageContext  `json:"page_context,omitempty"`
+}
+
+// SalesOrderResponse is the data returned by GetSalesOrder, CreateSalesOrder and UpdateSalesOrder
+type SalesOrderResponse struct {
+	Response
+	SalesOrder SalesOrder `json:"salesorder,omitempty"`
+}
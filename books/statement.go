@@ -0,0 +1,281 @@
+package books
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseOFXStatement reads the transactions of the OFX (or QFX) statement r into the payload of ImportBankStatement
+// for the bank account accountID. Both the SGML (OFX 1.x) and XML (OFX 2.x) formats are read, the FITID of a
+// transaction is used as its TransactionID and its NAME (or PAYEE) as Payee. A file holding the statements of
+// several accounts is rejected, as they would all be imported into accountID.
+func ParseOFXStatement(r io.Reader, accountID string) (BankStatementRequest, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return BankStatementRequest{}, fmt.Errorf("Failed to read OFX statement: %s", err)
+	}
+
+	statement := BankStatementRequest{AccountID: accountID}
+	var t *StatementTransaction
+	statements := 0
+	unescape := strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">", "&quot;", `"`, "&apos;", "'")
+
+	// every tag is followed by its value in SGML, closing tags are optional
+	for _, token := range strings.Split(string(b), "<")[1:] {
+		i := strings.Index(token, ">")
+		if i < 0 {
+			return BankStatementRequest{}, fmt.Errorf("Failed to parse OFX statement: unterminated tag <%s", token)
+		}
+		tag := strings.ToUpper(strings.TrimSpace(token[:i]))
+		value := strings.TrimSpace(unescape.Replace(token[i+1:]))
+
+		switch tag {
+		case "STMTRS", "CCSTMTRS":
+			statements++
+			if statements > 1 {
+				return BankStatementRequest{}, fmt.Errorf("Failed to parse OFX statement: the file holds more than 1 statement, split it by account")
+			}
+		case "STMTTRN":
+			t = &StatementTransaction{}
+		case "/STMTTRN":
+			if t == nil {
+				return BankStatementRequest{}, fmt.Errorf("Failed to parse OFX statement: unexpected </STMTTRN>")
+			}
+			if t.TransactionDate == "" {
+				return BankStatementRequest{}, fmt.Errorf("Failed to parse OFX statement: transaction %s has no DTPOSTED", t.TransactionID)
+			}
+			statement.Transactions = append(statement.Transactions, *t)
+			t = nil
+		case "DTSTART", "DTEND":
+			date, err := ofxDate(value)
+			if err != nil {
+				return BankStatementRequest{}, err
+			}
+			if tag == "DTSTART" {
+				statement.StartDate = date
+			} else {
+				statement.EndDate = date
+			}
+		}
+
+		if t == nil {
+			continue
+		}
+		switch tag {
+		case "FITID":
+			t.TransactionID = value
+		case "DTPOSTED":
+			if t.TransactionDate, err = ofxDate(value); err != nil {
+				return BankStatementRequest{}, err
+			}
+		case "TRNAMT":
+			amount, err := parseAmount(value)
+			if err != nil {
+				return BankStatementRequest{}, fmt.Errorf("Failed to parse OFX statement: %s", err)
+			}
+			t.Amount, t.DebitOrCredit = signedAmount(amount)
+		case "NAME", "PAYEE":
+			if t.Payee == "" {
+				t.Payee = value
+			}
+		case "MEMO":
+			t.Description = value
+		case "CHECKNUM", "REFNUM":
+			if t.ReferenceNumber == "" {
+				t.ReferenceNumber = value
+			}
+		}
+	}
+
+	if t != nil {
+		return BankStatementRequest{}, fmt.Errorf("Failed to parse OFX statement: unterminated transaction %s", t.TransactionID)
+	}
+	if statement.StartDate == "" || statement.EndDate == "" {
+		statement.StartDate, statement.EndDate = statementPeriod(statement.Transactions)
+	}
+	return statement, nil
+}
+
+// ofxDate returns the date of the OFX datetime value (yyyymmdd[hhmmss[.xxx]][[tz]]) formatted as yyyy-mm-dd
+func ofxDate(value string) (string, error) {
+	if len(value) < 8 {
+		return "", fmt.Errorf("Failed to parse OFX statement: invalid date '%s'", value)
+	}
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return "", fmt.Errorf("Failed to parse OFX statement: invalid date '%s'", value)
+	}
+	return date.Format("2006-01-02"), nil
+}
+
+// CSVStatementColumns are the names of the columns of a CSV statement, as found in its header row. Names are
+// compared ignoring case and surrounding spaces, only Date and either Amount or Debit and Credit are required.
+type CSVStatementColumns struct {
+	Date string
+	// DateLayout is the layout of the dates as expected by time.Parse, defaults to 2006-01-02
+	DateLayout string
+	// Amount holds signed amounts, negative amounts are debits (withdrawals)
+	Amount string
+	// Debit and Credit hold the amounts of withdrawals and deposits when the statement has a column for each
+	Debit           string
+	Credit          string
+	Payee           string
+	Description     string
+	ReferenceNumber string
+	TransactionID   string
+	// Comma is the field delimiter, defaults to ','
+	Comma rune
+}
+
+// ParseCSVStatement reads the transactions of the CSV statement r, described by columns, into the payload of
+// ImportBankStatement for the bank account accountID. The first row of r must be the header row, rows without
+// an amount are skipped. Amounts may hold thousands separators (',') and negative amounts may be in parentheses.
+func ParseCSVStatement(r io.Reader, accountID string, columns CSVStatementColumns) (BankStatementRequest, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if columns.Comma != 0 {
+		reader.Comma = columns.Comma
+	}
+	if columns.DateLayout == "" {
+		columns.DateLayout = "2006-01-02"
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return BankStatementRequest{}, fmt.Errorf("Failed to read CSV statement header: %s", err)
+	}
+	index := map[string]int{}
+	for i, h := range header {
+		index[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+	column := func(name string) (int, error) {
+		if name == "" {
+			return -1, nil
+		}
+		i, ok := index[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return -1, fmt.Errorf("Failed to parse CSV statement: no column '%s'", name)
+		}
+		return i, nil
+	}
+
+	if columns.Date == "" || (columns.Amount == "" && (columns.Debit == "" || columns.Credit == "")) {
+		return BankStatementRequest{}, fmt.Errorf("Failed to parse CSV statement: the Date and Amount (or Debit and Credit) columns are required")
+	}
+	cols := map[string]int{}
+	for key, name := range map[string]string{
+		"date": columns.Date, "amount": columns.Amount, "debit": columns.Debit, "credit": columns.Credit, "payee": columns.Payee,
+		"description": columns.Description, "reference": columns.ReferenceNumber, "id": columns.TransactionID,
+	} {
+		if cols[key], err = column(name); err != nil {
+			return BankStatementRequest{}, err
+		}
+	}
+
+	statement := BankStatementRequest{AccountID: accountID}
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return BankStatementRequest{}, fmt.Errorf("Failed to read CSV statement: %s", err)
+		}
+		field := func(key string) string {
+			if i := cols[key]; i >= 0 && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		var amount float64
+		if columns.Amount != "" {
+			if field("amount") == "" {
+				continue
+			}
+			if amount, err = parseAmount(field("amount")); err != nil {
+				return BankStatementRequest{}, fmt.Errorf("Failed to parse CSV statement line %d: %s", line, err)
+			}
+		} else {
+			debit, credit := field("debit"), field("credit")
+			if debit == "" && credit == "" {
+				continue
+			}
+			var d, c float64
+			if debit != "" {
+				if d, err = parseAmount(debit); err != nil {
+					return BankStatementRequest{}, fmt.Errorf("Failed to parse CSV statement line %d: %s", line, err)
+				}
+			}
+			if credit != "" {
+				if c, err = parseAmount(credit); err != nil {
+					return BankStatementRequest{}, fmt.Errorf("Failed to parse CSV statement line %d: %s", line, err)
+				}
+			}
+			amount = math.Abs(c) - math.Abs(d)
+		}
+
+		date, err := time.Parse(columns.DateLayout, field("date"))
+		if err != nil {
+			return BankStatementRequest{}, fmt.Errorf("Failed to parse CSV statement line %d: invalid date '%s'", line, field("date"))
+		}
+
+		t := StatementTransaction{
+			TransactionID:   field("id"),
+			TransactionDate: date.Format("2006-01-02"),
+			Payee:           field("payee"),
+			Description:     field("description"),
+			ReferenceNumber: field("reference"),
+		}
+		t.Amount, t.DebitOrCredit = signedAmount(amount)
+		statement.Transactions = append(statement.Transactions, t)
+	}
+
+	statement.StartDate, statement.EndDate = statementPeriod(statement.Transactions)
+	return statement, nil
+}
+
+// parseAmount parses a statement amount, ignoring thousands separators and reading (12.50) as -12.50
+func parseAmount(value string) (float64, error) {
+	v := strings.ReplaceAll(strings.ReplaceAll(value, ",", ""), " ", "")
+	negative := strings.HasPrefix(v, "(") && strings.HasSuffix(v, ")")
+	if negative {
+		v = v[1 : len(v)-1]
+	}
+	amount, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount '%s'", value)
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// signedAmount returns the positive amount and direction of a signed statement amount
+func signedAmount(amount float64) (float64, DebitOrCredit) {
+	if amount < 0 {
+		return -amount, Debit
+	}
+	return amount, Credit
+}
+
+// statementPeriod returns the first and last dates of transactions
+func statementPeriod(transactions []StatementTransaction) (start string, end string) {
+	for _, t := range transactions {
+		// yyyy-mm-dd dates compare in order as strings
+		if start == "" || t.TransactionDate < start {
+			start = t.TransactionDate
+		}
+		if t.TransactionDate > end {
+			end = t.TransactionDate
+		}
+	}
+	return start, end
+}
@@ -1 +1,125 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListTasks will return a page of the tasks of the project projectID, paged with params
+// https://www.zoho.com/books/api/v3/tasks/#list-tasks
+func (c *API) ListTasks(projectID string, params map[string]zoho.Parameter) (data TasksResponse, err error) {
+	endpoint := c.ListEndpoint(TasksModule, c.URL("%s/%s/tasks", ProjectsModule, projectID), &TasksResponse{}, params)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list tasks of project (%s)", projectID)); err != nil {
+		return TasksResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TasksResponse); ok {
+		return *v, nil
+	}
+
+	return TasksResponse{}, fmt.Errorf("Data retrieved was not 'TasksResponse'")
+}
+
+// GetTask will return the task id of the project projectID
+// https://www.zoho.com/books/api/v3/tasks/#get-a-task
+func (c *API) GetTask(projectID string, id string) (data TaskResponse, err error) {
+	endpoint := c.NewEndpoint(TasksModule, zoho.HTTPGet, c.URL("%s/%s/tasks/%s", ProjectsModule, projectID, id), &TaskResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve task (%s) of project (%s)", id, projectID)); err != nil {
+		return TaskResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaskResponse); ok {
+		return *v, nil
+	}
+
+	return TaskResponse{}, fmt.Errorf("Data retrieved was not 'TaskResponse'")
+}
+
+// CreateTask will create the task in request in the project projectID, TaskName is required
+// https://www.zoho.com/books/api/v3/tasks/#add-a-task
+func (c *API) CreateTask(request TaskRequest, projectID string) (data TaskResponse, err error) {
+	endpoint := c.NewEndpoint(TasksModule, zoho.HTTPPost, c.URL("%s/%s/tasks", ProjectsModule, projectID), &TaskResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to create task of project (%s)", projectID)); err != nil {
+		return TaskResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaskResponse); ok {
+		return *v, nil
+	}
+
+	return TaskResponse{}, fmt.Errorf("Data retrieved was not 'TaskResponse'")
+}
+
+// UpdateTask will update the task id of the project projectID with request
+// https://www.zoho.com/books/api/v3/tasks/#update-a-task
+func (c *API) UpdateTask(request TaskRequest, projectID string, id string) (data TaskResponse, err error) {
+	endpoint := c.NewEndpoint(TasksModule, zoho.HTTPPut, c.URL("%s/%s/tasks/%s", ProjectsModule, projectID, id), &TaskResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update task (%s) of project (%s)", id, projectID)); err != nil {
+		return TaskResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaskResponse); ok {
+		return *v, nil
+	}
+
+	return TaskResponse{}, fmt.Errorf("Data retrieved was not 'TaskResponse'")
+}
+
+// DeleteTask will delete the task id of the project projectID
+// https://www.zoho.com/books/api/v3/tasks/#delete-task
+func (c *API) DeleteTask(projectID string, id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(TasksModule, zoho.HTTPDelete, c.URL("%s/%s/tasks/%s", ProjectsModule, projectID, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete task (%s) of project (%s)", id, projectID)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// TaskRequest is the data provided to CreateTask and UpdateTask, Rate is the hourly rate of BasedOnTaskHours projects
+type TaskRequest struct {
+	TaskName    string  `json:"task_name,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Rate        float64 `json:"rate,omitempty"`
+	BudgetHours string  `json:"budget_hours,omitempty"`
+}
+
+// Task is a task of a project
+type Task struct {
+	TaskID        string  `json:"task_id,omitempty"`
+	TaskName      string  `json:"task_name,omitempty"`
+	ProjectID     string  `json:"project_id,omitempty"`
+	ProjectName   string  `json:"project_name,omitempty"`
+	CustomerID    string  `json:"customer_id,omitempty"`
+	Description   string  `json:"description,omitempty"`
+	Status        string  `json:"status,omitempty"`
+	IsBillable    bool    `json:"is_billable,omitempty"`
+	Rate          float64 `json:"rate,omitempty"`
+	BudgetHours   string  `json:"budget_hours,omitempty"`
+	TotalHours    string  `json:"total_hours,omitempty"`
+	BilledHours   string  `json:"billed_hours,omitempty"`
+	UnBilledHours string  `json:"un_billed_hours,omitempty"`
+}
+
+// TasksResponse is the data returned by ListTasks
+type TasksResponse struct {
+	Response
+	Tasks       []Task      `json:"task,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// TaskResponse is the data returned by GetTask, CreateTask and UpdateTask
+type TaskResponse struct {
+	Response
+	Task Task `json:"task,omitempty"`
+}
@@ -1 +1,550 @@
 package books
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListTaxes will return a page of the taxes and tax groups of the organization, paged with params
+// https://www.zoho.com/books/api/v3/taxes/#list-taxes
+func (c *API) ListTaxes(params map[string]zoho.Parameter) (data TaxesResponse, err error) {
+	endpoint := c.ListEndpoint(TaxesModule, c.URL(TaxesModule), &TaxesResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list taxes"); err != nil {
+		return TaxesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxesResponse); ok {
+		return *v, nil
+	}
+
+	return TaxesResponse{}, fmt.Errorf("Data retrieved was not 'TaxesResponse'")
+}
+
+// GetTax will return the tax specified by id
+// https://www.zoho.com/books/api/v3/taxes/#get-a-tax
+func (c *API) GetTax(id string) (data TaxResponse, err error) {
+	endpoint := c.NewEndpoint(TaxesModule, zoho.HTTPGet, c.URL("%s/%s", TaxesModule, id), &TaxResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve tax (%s)", id)); err != nil {
+		return TaxResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxResponse); ok {
+		return *v, nil
+	}
+
+	return TaxResponse{}, fmt.Errorf("Data retrieved was not 'TaxResponse'")
+}
+
+// CreateTax will create the tax in request, TaxName and TaxPercentage are required
+// https://www.zoho.com/books/api/v3/taxes/#create-a-tax
+func (c *API) CreateTax(request TaxRequest) (data TaxResponse, err error) {
+	endpoint := c.NewEndpoint(TaxesModule, zoho.HTTPPost, c.URL(TaxesModule), &TaxResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create tax"); err != nil {
+		return TaxResponse{}, err
+	}
+
+	c.ClearTaxCache()
+
+	if v, ok := endpoint.ResponseData.(*TaxResponse); ok {
+		return *v, nil
+	}
+
+	return TaxResponse{}, fmt.Errorf("Data retrieved was not 'TaxResponse'")
+}
+
+// UpdateTax will update the tax specified by id with request
+// https://www.zoho.com/books/api/v3/taxes/#update-a-tax
+func (c *API) UpdateTax(request TaxRequest, id string) (data TaxResponse, err error) {
+	endpoint := c.NewEndpoint(TaxesModule, zoho.HTTPPut, c.URL("%s/%s", TaxesModule, id), &TaxResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update tax (%s)", id)); err != nil {
+		return TaxResponse{}, err
+	}
+
+	c.ClearTaxCache()
+
+	if v, ok := endpoint.ResponseData.(*TaxResponse); ok {
+		return *v, nil
+	}
+
+	return TaxResponse{}, fmt.Errorf("Data retrieved was not 'TaxResponse'")
+}
+
+// DeleteTax will delete the tax specified by id
+// https://www.zoho.com/books/api/v3/taxes/#delete-a-tax
+func (c *API) DeleteTax(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(TaxesModule, zoho.HTTPDelete, c.URL("%s/%s", TaxesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete tax (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	c.ClearTaxCache()
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// GetTaxGroup will return the tax group specified by id
+// https://www.zoho.com/books/api/v3/taxes/#get-a-tax-group
+func (c *API) GetTaxGroup(id string) (data TaxGroupResponse, err error) {
+	endpoint := c.NewEndpoint(TaxGroupsModule, zoho.HTTPGet, c.URL("%s/%s", TaxGroupsModule, id), &TaxGroupResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve tax group (%s)", id)); err != nil {
+		return TaxGroupResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxGroupResponse); ok {
+		return *v, nil
+	}
+
+	return TaxGroupResponse{}, fmt.Errorf("Data retrieved was not 'TaxGroupResponse'")
+}
+
+// CreateTaxGroup will create the tax group in request, TaxGroupName and Taxes are required
+// https://www.zoho.com/books/api/v3/taxes/#create-a-tax-group
+func (c *API) CreateTaxGroup(request TaxGroupRequest) (data TaxGroupResponse, err error) {
+	endpoint := c.NewEndpoint(TaxGroupsModule, zoho.HTTPPost, c.URL(TaxGroupsModule), &TaxGroupResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create tax group"); err != nil {
+		return TaxGroupResponse{}, err
+	}
+
+	c.ClearTaxCache()
+
+	if v, ok := endpoint.ResponseData.(*TaxGroupResponse); ok {
+		return *v, nil
+	}
+
+	return TaxGroupResponse{}, fmt.Errorf("Data retrieved was not 'TaxGroupResponse'")
+}
+
+// UpdateTaxGroup will update the tax group specified by id with request
+// https://www.zoho.com/books/api/v3/taxes/#update-a-tax-group
+func (c *API) UpdateTaxGroup(request TaxGroupRequest, id string) (data TaxGroupResponse, err error) {
+	endpoint := c.NewEndpoint(TaxGroupsModule, zoho.HTTPPut, c.URL("%s/%s", TaxGroupsModule, id), &TaxGroupResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update tax group (%s)", id)); err != nil {
+		return TaxGroupResponse{}, err
+	}
+
+	c.ClearTaxCache()
+
+	if v, ok := endpoint.ResponseData.(*TaxGroupResponse); ok {
+		return *v, nil
+	}
+
+	return TaxGroupResponse{}, fmt.Errorf("Data retrieved was not 'TaxGroupResponse'")
+}
+
+// DeleteTaxGroup will delete the tax group specified by id
+// https://www.zoho.com/books/api/v3/taxes/#delete-a-tax-group
+func (c *API) DeleteTaxGroup(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(TaxGroupsModule, zoho.HTTPDelete, c.URL("%s/%s", TaxGroupsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete tax group (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	c.ClearTaxCache()
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ListTaxAuthorities will return the tax authorities of the organization
+// https://www.zoho.com/books/api/v3/taxes/#list-tax-authorities-us-edition-only-
+func (c *API) ListTaxAuthorities() (data TaxAuthoritiesResponse, err error) {
+	endpoint := c.NewEndpoint(TaxAuthoritiesModule, zoho.HTTPGet, c.URL(TaxAuthoritiesModule), &TaxAuthoritiesResponse{}, nil)
+
+	if err = c.Send(&endpoint, "Failed to list tax authorities"); err != nil {
+		return TaxAuthoritiesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxAuthoritiesResponse); ok {
+		return *v, nil
+	}
+
+	return TaxAuthoritiesResponse{}, fmt.Errorf("Data retrieved was not 'TaxAuthoritiesResponse'")
+}
+
+// GetTaxAuthority will return the tax authority specified by id
+// https://www.zoho.com/books/api/v3/taxes/#get-a-tax-authority-us-and-ca-edition-only-
+func (c *API) GetTaxAuthority(id string) (data TaxAuthorityResponse, err error) {
+	endpoint := c.NewEndpoint(TaxAuthoritiesModule, zoho.HTTPGet, c.URL("%s/%s", TaxAuthoritiesModule, id), &TaxAuthorityResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve tax authority (%s)", id)); err != nil {
+		return TaxAuthorityResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxAuthorityResponse); ok {
+		return *v, nil
+	}
+
+	return TaxAuthorityResponse{}, fmt.Errorf("Data retrieved was not 'TaxAuthorityResponse'")
+}
+
+// CreateTaxAuthority will create the tax authority in request, TaxAuthorityName is required
+// https://www.zoho.com/books/api/v3/taxes/#create-a-tax-authority-us-and-ca-edition-only-
+func (c *API) CreateTaxAuthority(request TaxAuthority) (data TaxAuthorityResponse, err error) {
+	endpoint := c.NewEndpoint(TaxAuthoritiesModule, zoho.HTTPPost, c.URL(TaxAuthoritiesModule), &TaxAuthorityResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create tax authority"); err != nil {
+		return TaxAuthorityResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxAuthorityResponse); ok {
+		return *v, nil
+	}
+
+	return TaxAuthorityResponse{}, fmt.Errorf("Data retrieved was not 'TaxAuthorityResponse'")
+}
+
+// UpdateTaxAuthority will update the tax authority specified by id with request
+// https://www.zoho.com/books/api/v3/taxes/#update-a-tax-authority-us-and-ca-edition-only-
+func (c *API) UpdateTaxAuthority(request TaxAuthority, id string) (data TaxAuthorityResponse, err error) {
+	endpoint := c.NewEndpoint(TaxAuthoritiesModule, zoho.HTTPPut, c.URL("%s/%s", TaxAuthoritiesModule, id), &TaxAuthorityResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update tax authority (%s)", id)); err != nil {
+		return TaxAuthorityResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxAuthorityResponse); ok {
+		return *v, nil
+	}
+
+	return TaxAuthorityResponse{}, fmt.Errorf("Data retrieved was not 'TaxAuthorityResponse'")
+}
+
+// DeleteTaxAuthority will delete the tax authority specified by id
+// https://www.zoho.com/books/api/v3/taxes/#delete-a-tax-authority-us-and-ca-edition-only-
+func (c *API) DeleteTaxAuthority(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(TaxAuthoritiesModule, zoho.HTTPDelete, c.URL("%s/%s", TaxAuthoritiesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete tax authority (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ListTaxExemptions will return the tax exemptions of the organization
+// https://www.zoho.com/books/api/v3/taxes/#list-tax-exemptions-us-edition-only-
+func (c *API) ListTaxExemptions() (data TaxExemptionsResponse, err error) {
+	endpoint := c.NewEndpoint(TaxExemptionsModule, zoho.HTTPGet, c.URL(TaxExemptionsModule), &TaxExemptionsResponse{}, nil)
+
+	if err = c.Send(&endpoint, "Failed to list tax exemptions"); err != nil {
+		return TaxExemptionsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxExemptionsResponse); ok {
+		return *v, nil
+	}
+
+	return TaxExemptionsResponse{}, fmt.Errorf("Data retrieved was not 'TaxExemptionsResponse'")
+}
+
+// GetTaxExemption will return the tax exemption specified by id
+// https://www.zoho.com/books/api/v3/taxes/#get-a-tax-exemption-us-edition-only-
+func (c *API) GetTaxExemption(id string) (data TaxExemptionResponse, err error) {
+	endpoint := c.NewEndpoint(TaxExemptionsModule, zoho.HTTPGet, c.URL("%s/%s", TaxExemptionsModule, id), &TaxExemptionResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve tax exemption (%s)", id)); err != nil {
+		return TaxExemptionResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxExemptionResponse); ok {
+		return *v, nil
+	}
+
+	return TaxExemptionResponse{}, fmt.Errorf("Data retrieved was not 'TaxExemptionResponse'")
+}
+
+// CreateTaxExemption will create the tax exemption in request, TaxExemptionCode and Type are required
+// https://www.zoho.com/books/api/v3/taxes/#create-a-tax-exemption-us-edition-only-
+func (c *API) CreateTaxExemption(request TaxExemption) (data TaxExemptionResponse, err error) {
+	endpoint := c.NewEndpoint(TaxExemptionsModule, zoho.HTTPPost, c.URL(TaxExemptionsModule), &TaxExemptionResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create tax exemption"); err != nil {
+		return TaxExemptionResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxExemptionResponse); ok {
+		return *v, nil
+	}
+
+	return TaxExemptionResponse{}, fmt.Errorf("Data retrieved was not 'TaxExemptionResponse'")
+}
+
+// UpdateTaxExemption will update the tax exemption specified by id with request
+// https://www.zoho.com/books/api/v3/taxes/#update-a-tax-exemption-us-edition-only-
+func (c *API) UpdateTaxExemption(request TaxExemption, id string) (data TaxExemptionResponse, err error) {
+	endpoint := c.NewEndpoint(TaxExemptionsModule, zoho.HTTPPut, c.URL("%s/%s", TaxExemptionsModule, id), &TaxExemptionResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update tax exemption (%s)", id)); err != nil {
+		return TaxExemptionResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxExemptionResponse); ok {
+		return *v, nil
+	}
+
+	return TaxExemptionResponse{}, fmt.Errorf("Data retrieved was not 'TaxExemptionResponse'")
+}
+
+// DeleteTaxExemption will delete the tax exemption specified by id
+// https://www.zoho.com/books/api/v3/taxes/#delete-a-tax-exemption-us-edition-only-
+func (c *API) DeleteTaxExemption(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(TaxExemptionsModule, zoho.HTTPDelete, c.URL("%s/%s", TaxExemptionsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete tax exemption (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// TaxRequest is the data provided to CreateTax and UpdateTax, TaxType is "tax" or "compound_tax"
+type TaxRequest struct {
+	TaxName          string  `json:"tax_name,omitempty"`
+	TaxPercentage    float64 `json:"tax_percentage,omitempty"`
+	TaxType          string  `json:"tax_type,omitempty"`
+	TaxAuthorityID   string  `json:"tax_authority_id,omitempty"`
+	TaxAuthorityName string  `json:"tax_authority_name,omitempty"`
+	IsValueAdded     bool    `json:"is_value_added,omitempty"`
+	CountryCode      string  `json:"country_code,omitempty"`
+	// the Update fields also apply an updated tax to the recurring transactions and drafts using it
+	UpdateRecurringInvoice bool `json:"update_recurring_invoice,omitempty"`
+	UpdateRecurringExpense bool `json:"update_recurring_expense,omitempty"`
+	UpdateDraftInvoice     bool `json:"update_draft_invoice,omitempty"`
+	UpdateRecurringBills   bool `json:"update_recurring_bills,omitempty"`
+	UpdateDraftSO          bool `json:"update_draft_so,omitempty"`
+	UpdateSubscription     bool `json:"update_subscription,omitempty"`
+	UpdateProject          bool `json:"update_project,omitempty"`
+}
+
+// TaxDetails is a tax or a tax group of the organization, TaxType is "tax", "compound_tax" or "tax_group"
+type TaxDetails struct {
+	TaxID            string  `json:"tax_id,omitempty"`
+	TaxName          string  `json:"tax_name,omitempty"`
+	TaxPercentage    float64 `json:"tax_percentage,omitempty"`
+	TaxType          string  `json:"tax_type,omitempty"`
+	TaxSpecificType  string  `json:"tax_specific_type,omitempty"`
+	TaxAuthorityID   string  `json:"tax_authority_id,omitempty"`
+	TaxAuthorityName string  `json:"tax_authority_name,omitempty"`
+	IsValueAdded     bool    `json:"is_value_added,omitempty"`
+	IsDefaultTax     bool    `json:"is_default_tax,omitempty"`
+	IsEditable       bool    `json:"is_editable,omitempty"`
+	Status           string  `json:"status,omitempty"`
+}
+
+// TaxesResponse is the data returned by ListTaxes
+type TaxesResponse struct {
+	Response
+	Taxes       []TaxDetails `json:"taxes,omitempty"`
+	PageContext PageContext  `json:"page_context,omitempty"`
+}
+
+// TaxResponse is the data returned by GetTax, CreateTax and UpdateTax
+type TaxResponse struct {
+	Response
+	Tax TaxDetails `json:"tax,omitempty"`
+}
+
+// TaxGroupRequest is the data provided to CreateTaxGroup and UpdateTaxGroup, Taxes is the comma separated ids of the taxes
+type TaxGroupRequest struct {
+	TaxGroupName string `json:"tax_group_name,omitempty"`
+	Taxes        string `json:"taxes,omitempty"`
+}
+
+// TaxGroupResponse is the data returned by GetTaxGroup, CreateTaxGroup and UpdateTaxGroup
+type TaxGroupResponse struct {
+	Response
+	TaxGroup struct {
+		TaxGroupID         string       `json:"tax_group_id,omitempty"`
+		TaxGroupName       string       `json:"tax_group_name,omitempty"`
+		TaxGroupPercentage float64      `json:"tax_group_percentage,omitempty"`
+		Taxes              []TaxDetails `json:"taxes,omitempty"`
+	} `json:"tax_group,omitempty"`
+}
+
+// TaxAuthority is the authority taxes are paid to
+type TaxAuthority struct {
+	TaxAuthorityID     string `json:"tax_authority_id,omitempty"`
+	TaxAuthorityName   string `json:"tax_authority_name,omitempty"`
+	Description        string `json:"description,omitempty"`
+	RegistrationNumber string `json:"registration_number,omitempty"`
+}
+
+// TaxAuthoritiesResponse is the data returned by ListTaxAuthorities
+type TaxAuthoritiesResponse struct {
+	Response
+	TaxAuthorities []TaxAuthority `json:"taxauthorities,omitempty"`
+}
+
+// TaxAuthorityResponse is the data returned by GetTaxAuthority, CreateTaxAuthority and UpdateTaxAuthority
+type TaxAuthorityResponse struct {
+	Response
+	TaxAuthority TaxAuthority `json:"taxauthority,omitempty"`
+}
+
+// TaxExemption is the reason a customer or an item is exempt from taxes, Type is "customer" or "item"
+type TaxExemption struct {
+	TaxExemptionID   string `json:"tax_exemption_id,omitempty"`
+	TaxExemptionCode string `json:"tax_exemption_code,omitempty"`
+	Description      string `json:"description,omitempty"`
+	Type             string `json:"type,omitempty"`
+}
+
+// TaxExemptionsResponse is the data returned by ListTaxExemptions
+type TaxExemptionsResponse struct {
+	Response
+	TaxExemptions []TaxExemption `json:"tax_exemptions,omitempty"`
+}
+
+// TaxExemptionResponse is the data returned by GetTaxExemption, CreateTaxExemption and UpdateTaxExemption
+type TaxExemptionResponse struct {
+	Response
+	TaxExemption TaxExemption `json:"tax_exemption,omitempty"`
+}
+
+// DefaultTaxCacheTTL is how long the taxes used by FindTax and FindTaxByPercentage are cached by default
+const DefaultTaxCacheTTL = 10 * time.Minute
+
+// taxCache keeps the taxes of an organization in memory, the zero value uses DefaultTaxCacheTTL
+type taxCache struct {
+	mu           sync.Mutex
+	ttl          time.Duration
+	disabled     bool
+	organization string
+	taxes        []TaxDetails
+	expires      time.Time
+}
+
+// SetTaxCacheTTL sets how long the taxes used by FindTax and FindTaxByPercentage are cached, zero disables the cache
+// and drops the cached taxes
+func (c *API) SetTaxCacheTTL(ttl time.Duration) {
+	c.taxes.mu.Lock()
+	c.taxes.ttl = ttl
+	c.taxes.disabled = ttl <= 0
+	if c.taxes.disabled {
+		c.taxes.taxes = nil
+	}
+	c.taxes.mu.Unlock()
+}
+
+// ClearTaxCache drops the cached taxes, it is called by the methods changing taxes and tax groups
+func (c *API) ClearTaxCache() {
+	c.taxes.mu.Lock()
+	c.taxes.taxes = nil
+	c.taxes.mu.Unlock()
+}
+
+// cachedTaxes returns every tax and tax group of the organization, from the cache when it is still valid
+func (c *API) cachedTaxes() ([]TaxDetails, error) {
+	organization := c.Organization()
+
+	c.taxes.mu.Lock()
+	if !c.taxes.disabled && c.taxes.taxes != nil && c.taxes.organization == organization && time.Now().Before(c.taxes.expires) {
+		taxes := c.taxes.taxes
+		c.taxes.mu.Unlock()
+		return taxes, nil
+	}
+	c.taxes.mu.Unlock()
+
+	taxes := []TaxDetails{}
+	err := ForEachPage(nil, func(params map[string]zoho.Parameter) (PageContext, error) {
+		resp, err := c.ListTaxes(params)
+		taxes = append(taxes, resp.Taxes...)
+		return resp.PageContext, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.taxes.mu.Lock()
+	defer c.taxes.mu.Unlock()
+	if !c.taxes.disabled {
+		ttl := c.taxes.ttl
+		if ttl <= 0 {
+			ttl = DefaultTaxCacheTTL
+		}
+		c.taxes.organization = organization
+		c.taxes.taxes = taxes
+		c.taxes.expires = time.Now().Add(ttl)
+	}
+	return taxes, nil
+}
+
+// FindTax returns the tax or tax group of the organization named name, names are compared ignoring case and
+// surrounding spaces. The taxes are cached, see SetTaxCacheTTL.
+//
+//	tax, err := c.FindTax("VAT 20%")
+//	line := books.LineItem{Name: "Consulting", Rate: 120, Quantity: 8, TaxID: tax.TaxID}
+func (c *API) FindTax(name string) (TaxDetails, error) {
+	taxes, err := c.cachedTaxes()
+	if err != nil {
+		return TaxDetails{}, err
+	}
+	for _, t := range taxes {
+		if strings.EqualFold(strings.TrimSpace(t.TaxName), strings.TrimSpace(name)) {
+			return t, nil
+		}
+	}
+	return TaxDetails{}, fmt.Errorf("Failed to find tax '%s'", name)
+}
+
+// FindTaxByPercentage returns the tax of the organization of percentage, tax groups are ignored. When several taxes
+// have the same percentage the default tax of the organization is returned, or an error when none of them is the default.
+// The taxes are cached, see SetTaxCacheTTL.
+func (c *API) FindTaxByPercentage(percentage float64) (TaxDetails, error) {
+	taxes, err := c.cachedTaxes()
+	if err != nil {
+		return TaxDetails{}, err
+	}
+
+	var found []TaxDetails
+	for _, t := range taxes {
+		// percentages are compared to 4 decimals to avoid floating point errors
+		if t.TaxType != "tax_group" && math.Round(t.TaxPercentage*10000) == math.Round(percentage*10000) {
+			found = append(found, t)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return TaxDetails{}, fmt.Errorf("Failed to find tax of %v%%", percentage)
+	case 1:
+		return found[0], nil
+	}
+
+	names := make([]string, len(found))
+	for i, t := range found {
+		if t.IsDefaultTax {
+			return t, nil
+		}
+		names[i] = t.TaxName
+	}
+	return TaxDetails{}, fmt.Errorf("Failed to find tax of %v%%: several taxes match (%s)", percentage, strings.Join(names, ", "))
+}
@@ -1 +1,339 @@
 package books
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListTimeEntries will return a page of the time entries of the organization, filtered and paged with params
+// (eg. 'project_id', 'user_id', 'filter_by', 'from_date', 'to_date', 'sort_column', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/time-entries/#list-time-entries
+func (c *API) ListTimeEntries(params map[string]zoho.Parameter) (data TimeEntriesResponse, err error) {
+	endpoint := c.ListEndpoint(TimeEntriesModule, c.URL(TimeEntriesModule), &TimeEntriesResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list time entries"); err != nil {
+		return TimeEntriesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntriesResponse); ok {
+		return *v, nil
+	}
+
+	return TimeEntriesResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntriesResponse'")
+}
+
+// ListTimeEntriesBetween will return a page of the time entries logged from the date from to the date to (yyyy-mm-dd),
+// filtered and paged with params (eg. 'project_id', 'user_id', 'page')
+// https://www.zoho.com/books/api/v3/time-entries/#list-time-entries
+func (c *API) ListTimeEntriesBetween(from string, to string, params map[string]zoho.Parameter) (data TimeEntriesResponse, err error) {
+	p := map[string]zoho.Parameter{
+		"filter_by": "Date.CustomDate",
+		"from_date": zoho.Parameter(from),
+		"to_date":   zoho.Parameter(to),
+	}
+	for k, v := range params {
+		p[k] = v
+	}
+	return c.ListTimeEntries(p)
+}
+
+// GetTimeEntry will return the time entry specified by id
+// https://www.zoho.com/books/api/v3/time-entries/#get-a-time-entry
+func (c *API) GetTimeEntry(id string) (data TimeEntryResponse, err error) {
+	endpoint := c.NewEndpoint(TimeEntriesModule, zoho.HTTPGet, c.URL("%s/%s", TimeEntriesModule, id), &TimeEntryResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve time entry (%s)", id)); err != nil {
+		return TimeEntryResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// LogTime will log the time entry in request, ProjectID, TaskID, UserID and LogDate are required
+// as well as either LogTime or BeginTime and EndTime
+// https://www.zoho.com/books/api/v3/time-entries/#log-time-entries
+func (c *API) LogTime(request TimeEntryRequest) (data TimeEntryResponse, err error) {
+	endpoint := c.NewEndpoint(TimeEntriesModule, zoho.HTTPPost, c.URL(TimeEntriesModule), &TimeEntryResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to log time entry"); err != nil {
+		return TimeEntryResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// UpdateTimeEntry will update the time entry specified by id with request
+// https://www.zoho.com/books/api/v3/time-entries/#update-time-entry
+func (c *API) UpdateTimeEntry(request TimeEntryRequest, id string) (data TimeEntryResponse, err error) {
+	endpoint := c.NewEndpoint(TimeEntriesModule, zoho.HTTPPut, c.URL("%s/%s", TimeEntriesModule, id), &TimeEntryResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update time entry (%s)", id)); err != nil {
+		return TimeEntryResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// DeleteTimeEntry will delete the time entry specified by id
+// https://www.zoho.com/books/api/v3/time-entries/#delete-time-entry
+func (c *API) DeleteTimeEntry(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(TimeEntriesModule, zoho.HTTPDelete, c.URL("%s/%s", TimeEntriesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete time entry (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// StartTimer will start the timer of the time entry specified by id
+// https://www.zoho.com/books/api/v3/time-entries/#start-timer
+func (c *API) StartTimer(id string) (data TimeEntryResponse, err error) {
+	endpoint := c.NewEndpoint(TimeEntriesModule, zoho.HTTPPost, c.URL("%s/%s/timer/start", TimeEntriesModule, id), &TimeEntryResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to start timer of time entry (%s)", id)); err != nil {
+		return TimeEntryResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// StopTimer will stop the running timer of the current user, the time is added to its time entry
+// https://www.zoho.com/books/api/v3/time-entries/#stop-timer
+func (c *API) StopTimer() (data TimeEntryResponse, err error) {
+	endpoint := c.NewEndpoint(TimeEntriesModule, zoho.HTTPPost, c.URL("%s/timer/stop", TimeEntriesModule), &TimeEntryResponse{}, nil)
+
+	if err = c.Send(&endpoint, "Failed to stop timer"); err != nil {
+		return TimeEntryResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// GetRunningTimer will return the time entry whose timer is running for the current user
+// https://www.zoho.com/books/api/v3/time-entries/#get-timer
+func (c *API) GetRunningTimer() (data TimeEntryResponse, err error) {
+	endpoint := c.NewEndpoint(TimeEntriesModule, zoho.HTTPGet, c.URL("%s/runningtimer/me", TimeEntriesModule), &TimeEntryResponse{}, nil)
+
+	if err = c.Send(&endpoint, "Failed to retrieve running timer"); err != nil {
+		return TimeEntryResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// TimeEntryRequest is the data provided to LogTime and UpdateTimeEntry. Times are formatted as hh:mm, LogTime is the
+// duration logged. StartTimer starts the timer of the entry as soon as it is logged.
+type TimeEntryRequest struct {
+	ProjectID    string        `json:"project_id,omitempty"`
+	TaskID       string        `json:"task_id,omitempty"`
+	UserID       string        `json:"user_id,omitempty"`
+	LogDate      string        `json:"log_date,omitempty"`
+	BeginTime    string        `json:"begin_time,omitempty"`
+	EndTime      string        `json:"end_time,omitempty"`
+	LogTime      string        `json:"log_time,omitempty"`
+	IsBillable   *bool         `json:"is_billable,omitempty"`
+	Notes        string        `json:"notes,omitempty"`
+	StartTimer   bool          `json:"start_timer,omitempty"`
+	CustomFields []CustomField `json:"custom_fields,omitempty"`
+}
+
+// TimeEntry is a time logged by a user on a task of a project, BilledStatus is "unbilled", "billed" or "nonbillable"
+type TimeEntry struct {
+	TimeEntryID            string `json:"time_entry_id,omitempty"`
+	ProjectID              string `json:"project_id,omitempty"`
+	ProjectName            string `json:"project_name,omitempty"`
+	CustomerID             string `json:"customer_id,omitempty"`
+	CustomerName           string `json:"customer_name,omitempty"`
+	TaskID                 string `json:"task_id,omitempty"`
+	TaskName               string `json:"task_name,omitempty"`
+	UserID                 string `json:"user_id,omitempty"`
+	UserName               string `json:"user_name,omitempty"`
+	IsCurrentUser          bool   `json:"is_current_user,omitempty"`
+	LogDate                string `json:"log_date,omitempty"`
+	BeginTime              string `json:"begin_time,omitempty"`
+	EndTime                string `json:"end_time,omitempty"`
+	LogTime                string `json:"log_time,omitempty"`
+	IsBillable             bool   `json:"is_billable,omitempty"`
+	BilledStatus           string `json:"billed_status,omitempty"`
+	InvoiceID              string `json:"invoice_id,omitempty"`
+	Notes                  string `json:"notes,omitempty"`
+	TimerStartedAt         string `json:"timer_started_at,omitempty"`
+	TimerDurationInMinutes int    `json:"timer_duration_in_minutes,omitempty"`
+	CreatedTime            string `json:"created_time,omitempty"`
+}
+
+// Hours returns the duration of LogTime in hours
+func (t TimeEntry) Hours() (float64, error) {
+	return logTimeHours(t.LogTime)
+}
+
+// TimeEntriesResponse is the data returned by ListTimeEntries and ListTimeEntriesBetween
+type TimeEntriesResponse struct {
+	Response
+	TimeEntries []TimeEntry `json:"time_entries,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// TimeEntryResponse is the data returned by GetTimeEntry, LogTime, UpdateTimeEntry and the timer methods
+type TimeEntryResponse struct {
+	Response
+	TimeEntry TimeEntry `json:"time_entry,omitempty"`
+}
+
+// logTimeHours returns the duration hh:mm in hours
+func logTimeHours(logTime string) (float64, error) {
+	parts := strings.SplitN(logTime, ":", 2)
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time '%s'", logTime)
+	}
+	minutes := 0
+	if len(parts) == 2 {
+		if minutes, err = strconv.Atoi(parts[1]); err != nil || minutes < 0 || minutes > 59 {
+			return 0, fmt.Errorf("invalid time '%s'", logTime)
+		}
+	}
+	return float64(hours) + float64(minutes)/60, nil
+}
+
+// InvoiceTimeOptions is the data provided to InvoiceUnbilledTime
+type InvoiceTimeOptions struct {
+	// FromDate and ToDate (yyyy-mm-dd) restrict the time entries invoiced to the ones logged in the period, both are optional
+	FromDate string
+	ToDate   string
+	// Invoice is the invoice created, CustomerID defaults to the customer of the project.
+	// The line items billing the time entries are added after the line items of Invoice.
+	Invoice InvoiceRequest
+}
+
+// InvoiceUnbilledTime creates an invoice billing the unbilled time entries of the project projectID as described by opts,
+// once invoiced Books marks the time entries as billed. The time is billed at the rate of the project, of the users or
+// of the tasks depending on the billing type of the project, a line item is created for the project, for every user or
+// for every task. Projects billed at a fixed cost cannot be invoiced by time.
+func (c *API) InvoiceUnbilledTime(projectID string, opts InvoiceTimeOptions) (data InvoiceResponse, err error) {
+	project, err := c.GetProject(projectID)
+	if err != nil {
+		return InvoiceResponse{}, err
+	}
+	if project.Project.BillingType == FixedCostForProject {
+		return InvoiceResponse{}, fmt.Errorf("Failed to invoice time of project (%s): the project is billed at a fixed cost", projectID)
+	}
+
+	var entries []TimeEntry
+	err = ForEachPage(map[string]zoho.Parameter{"project_id": zoho.Parameter(projectID)}, func(params map[string]zoho.Parameter) (PageContext, error) {
+		resp, err := c.ListTimeEntries(params)
+		for _, t := range resp.TimeEntries {
+			if !t.IsBillable || t.BilledStatus != "unbilled" {
+				continue
+			}
+			if (opts.FromDate != "" && t.LogDate < opts.FromDate) || (opts.ToDate != "" && t.LogDate > opts.ToDate) {
+				continue
+			}
+			entries = append(entries, t)
+		}
+		return resp.PageContext, err
+	})
+	if err != nil {
+		return InvoiceResponse{}, err
+	}
+	if len(entries) == 0 {
+		return InvoiceResponse{}, fmt.Errorf("Failed to invoice time of project (%s): no unbilled time entries", projectID)
+	}
+
+	// rates are found by user or by task depending on the billing type, a single rate is used for project hours
+	rates := map[string]float64{}
+	key := func(t TimeEntry) string { return "" }
+	name := func(t TimeEntry) string { return project.Project.ProjectName }
+	switch project.Project.BillingType {
+	case BasedOnStaffHours:
+		users, err := c.ListProjectUsers(projectID)
+		if err != nil {
+			return InvoiceResponse{}, err
+		}
+		for _, u := range users.Users {
+			rates[u.UserID] = u.Rate
+		}
+		key = func(t TimeEntry) string { return t.UserID }
+		name = func(t TimeEntry) string { return project.Project.ProjectName + " - " + t.UserName }
+	case BasedOnTaskHours:
+		err = ForEachPage(nil, func(params map[string]zoho.Parameter) (PageContext, error) {
+			resp, err := c.ListTasks(projectID, params)
+			for _, t := range resp.Tasks {
+				rates[t.TaskID] = t.Rate
+			}
+			return resp.PageContext, err
+		})
+		if err != nil {
+			return InvoiceResponse{}, err
+		}
+		key = func(t TimeEntry) string { return t.TaskID }
+		name = func(t TimeEntry) string { return project.Project.ProjectName + " - " + t.TaskName }
+	default:
+		rates[""] = project.Project.Rate
+	}
+
+	var keys []string
+	lines := map[string]*LineItem{}
+	for _, t := range entries {
+		hours, err := t.Hours()
+		if err != nil {
+			return InvoiceResponse{}, fmt.Errorf("Failed to invoice time entry (%s): %s", t.TimeEntryID, err)
+		}
+		k := key(t)
+		rate, ok := rates[k]
+		if !ok {
+			return InvoiceResponse{}, fmt.Errorf("Failed to invoice time entry (%s): no rate for %s", t.TimeEntryID, name(t))
+		}
+		if _, ok := lines[k]; !ok {
+			keys = append(keys, k)
+			lines[k] = &LineItem{ProjectID: projectID, Name: name(t), Rate: rate, Unit: "hrs"}
+		}
+		lines[k].Quantity += hours
+		lines[k].TimeEntryIDs = append(lines[k].TimeEntryIDs, t.TimeEntryID)
+	}
+
+	request := opts.Invoice
+	if request.CustomerID == "" {
+		request.CustomerID = project.Project.CustomerID
+	}
+	request.ProjectID = projectID
+	for _, k := range keys {
+		lines[k].Quantity = math.Round(lines[k].Quantity*100) / 100
+		request.LineItems = append(request.LineItems, *lines[k])
+	}
+
+	return c.CreateInvoice(request)
+}
@@ -1 +1,192 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListUsers will return a page of the users of the organization, filtered and paged with params
+// (eg. 'filter_by', 'sort_column', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/users/#list-users
+func (c *API) ListUsers(params map[string]zoho.Parameter) (data UsersResponse, err error) {
+	endpoint := c.ListEndpoint(UsersModule, c.URL(UsersModule), &UsersResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list users"); err != nil {
+		return UsersResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*UsersResponse); ok {
+		return *v, nil
+	}
+
+	return UsersResponse{}, fmt.Errorf("Data retrieved was not 'UsersResponse'")
+}
+
+// GetUser will return the user specified by id
+// https://www.zoho.com/books/api/v3/users/#get-an-user
+func (c *API) GetUser(id string) (data UserResponse, err error) {
+	endpoint := c.NewEndpoint(UsersModule, zoho.HTTPGet, c.URL("%s/%s", UsersModule, id), &UserResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve user (%s)", id)); err != nil {
+		return UserResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*UserResponse); ok {
+		return *v, nil
+	}
+
+	return UserResponse{}, fmt.Errorf("Data retrieved was not 'UserResponse'")
+}
+
+// CreateUser will create the user in request, Name and Email are required. The user is invited to the organization
+// https://www.zoho.com/books/api/v3/users/#create-an-user
+func (c *API) CreateUser(request UserRequest) (data UserResponse, err error) {
+	endpoint := c.NewEndpoint(UsersModule, zoho.HTTPPost, c.URL(UsersModule), &UserResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create user"); err != nil {
+		return UserResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*UserResponse); ok {
+		return *v, nil
+	}
+
+	return UserResponse{}, fmt.Errorf("Data retrieved was not 'UserResponse'")
+}
+
+// UpdateUser will update the user specified by id with request
+// https://www.zoho.com/books/api/v3/users/#update-an-user
+func (c *API) UpdateUser(request UserRequest, id string) (data UserResponse, err error) {
+	endpoint := c.NewEndpoint(UsersModule, zoho.HTTPPut, c.URL("%s/%s", UsersModule, id), &UserResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update user (%s)", id)); err != nil {
+		return UserResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*UserResponse); ok {
+		return *v, nil
+	}
+
+	return UserResponse{}, fmt.Errorf("Data retrieved was not 'UserResponse'")
+}
+
+// DeleteUser will delete the user specified by id
+// https://www.zoho.com/books/api/v3/users/#delete-an-user
+func (c *API) DeleteUser(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(UsersModule, zoho.HTTPDelete, c.URL("%s/%s", UsersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete user (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// GetCurrentUser will return the user the requests are made for
+// https://www.zoho.com/books/api/v3/users/#get-current-user
+func (c *API) GetCurrentUser() (data UserResponse, err error) {
+	endpoint := c.NewEndpoint(UsersModule, zoho.HTTPGet, c.URL("%s/me", UsersModule), &UserResponse{}, nil)
+
+	if err = c.Send(&endpoint, "Failed to retrieve current user"); err != nil {
+		return UserResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*UserResponse); ok {
+		return *v, nil
+	}
+
+	return UserResponse{}, fmt.Errorf("Data retrieved was not 'UserResponse'")
+}
+
+// InviteUser will send the invitation to the organization again to the user specified by id
+// https://www.zoho.com/books/api/v3/users/#invite-an-user
+func (c *API) InviteUser(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(UsersModule, zoho.HTTPPost, c.URL("%s/%s/invite", UsersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to invite user (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkUserActive will mark the user specified by id as active
+// https://www.zoho.com/books/api/v3/users/#mark-user-as-active
+func (c *API) MarkUserActive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(UsersModule, zoho.HTTPPost, c.URL("%s/%s/active", UsersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark user (%s) as active", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkUserInactive will mark the user specified by id as inactive
+// https://www.zoho.com/books/api/v3/users/#mark-user-as-inactive
+func (c *API) MarkUserInactive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(UsersModule, zoho.HTTPPost, c.URL("%s/%s/inactive", UsersModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark user (%s) as inactive", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// UserRequest is the data provided to CreateUser and UpdateUser, UserRole is eg. "admin", "staff" or "timesheetstaff"
+type UserRequest struct {
+	Name        string  `json:"name,omitempty"`
+	Email       string  `json:"email,omitempty"`
+	UserRole    string  `json:"user_role,omitempty"`
+	Rate        float64 `json:"rate,omitempty"`
+	BudgetHours string  `json:"budget_hours,omitempty"`
+	CostRate    float64 `json:"cost_rate,omitempty"`
+}
+
+// User is a user of the organization
+type User struct {
+	UserID              string  `json:"user_id,omitempty"`
+	Name                string  `json:"name,omitempty"`
+	Email               string  `json:"email,omitempty"`
+	UserRole            string  `json:"user_role,omitempty"`
+	UserType            string  `json:"user_type,omitempty"`
+	Status              string  `json:"status,omitempty"`
+	IsCurrentUser       bool    `json:"is_current_user,omitempty"`
+	Photo               string  `json:"photo_url,omitempty"`
+	Rate                float64 `json:"rate,omitempty"`
+	CostRate            float64 `json:"cost_rate,omitempty"`
+	IsCustomerSegmented bool    `json:"is_customer_segmented,omitempty"`
+	IsVendorSegmented   bool    `json:"is_vendor_segmented,omitempty"`
+	CreatedTime         string  `json:"created_time,omitempty"`
+}
+
+// UsersResponse is the data returned by ListUsers
+type UsersResponse struct {
+	Response
+	Users       []User      `json:"users,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// UserResponse is the data returned by GetUser, CreateUser, UpdateUser and GetCurrentUser
+type UserResponse struct {
+	Response
+	User User `json:"user,omitempty"`
+}
@@ -1 +1,236 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListVendorCredits will return a page of the vendor credits of the organization, filtered and paged with params
+// (eg. 'vendor_id', 'status', 'date_start', 'date_end', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/vendor-credits/#list-vendor-credits
+func (c *API) ListVendorCredits(params map[string]zoho.Parameter) (data VendorCreditsResponse, err error) {
+	endpoint := c.ListEndpoint(VendorCreditsModule, c.URL(VendorCreditsModule), &VendorCreditsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list vendor credits"); err != nil {
+		return VendorCreditsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorCreditsResponse); ok {
+		return *v, nil
+	}
+
+	return VendorCreditsResponse{}, fmt.Errorf("Data retrieved was not 'VendorCreditsResponse'")
+}
+
+// GetVendorCredit will return the vendor credit specified by id
+// https://www.zoho.com/books/api/v3/vendor-credits/#get-vendor-credit
+func (c *API) GetVendorCredit(id string) (data VendorCreditResponse, err error) {
+	endpoint := c.NewEndpoint(VendorCreditsModule, zoho.HTTPGet, c.URL("%s/%s", VendorCreditsModule, id), &VendorCreditResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve vendor credit (%s)", id)); err != nil {
+		return VendorCreditResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorCreditResponse); ok {
+		return *v, nil
+	}
+
+	return VendorCreditResponse{}, fmt.Errorf("Data retrieved was not 'VendorCreditResponse'")
+}
+
+// CreateVendorCredit will create the vendor credit in request, VendorID and LineItems are required
+// https://www.zoho.com/books/api/v3/vendor-credits/#create-a-vendor-credit
+func (c *API) CreateVendorCredit(request VendorCreditRequest) (data VendorCreditResponse, err error) {
+	endpoint := c.NewEndpoint(VendorCreditsModule, zoho.HTTPPost, c.URL(VendorCreditsModule), &VendorCreditResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create vendor credit"); err != nil {
+		return VendorCreditResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorCreditResponse); ok {
+		return *v, nil
+	}
+
+	return VendorCreditResponse{}, fmt.Errorf("Data retrieved was not 'VendorCreditResponse'")
+}
+
+// UpdateVendorCredit will update the vendor credit specified by id with request
+// https://www.zoho.com/books/api/v3/vendor-credits/#update-vendor-credit
+func (c *API) UpdateVendorCredit(request VendorCreditRequest, id string) (data VendorCreditResponse, err error) {
+	endpoint := c.NewEndpoint(VendorCreditsModule, zoho.HTTPPut, c.URL("%s/%s", VendorCreditsModule, id), &VendorCreditResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update vendor credit (%s)", id)); err != nil {
+		return VendorCreditResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorCreditResponse); ok {
+		return *v, nil
+	}
+
+	return VendorCreditResponse{}, fmt.Errorf("Data retrieved was not 'VendorCreditResponse'")
+}
+
+// DeleteVendorCredit will delete the vendor credit specified by id
+// https://www.zoho.com/books/api/v3/vendor-credits/#delete-vendor-credit
+func (c *API) DeleteVendorCredit(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(VendorCreditsModule, zoho.HTTPDelete, c.URL("%s/%s", VendorCreditsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete vendor credit (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkVendorCreditOpen will mark the draft or void vendor credit specified by id as open
+// https://www.zoho.com/books/api/v3/vendor-credits/#convert-to-open
+func (c *API) MarkVendorCreditOpen(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(VendorCreditsModule, zoho.HTTPPost, c.URL("%s/%s/status/open", VendorCreditsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark vendor credit (%s) as open", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// VoidVendorCredit will mark the vendor credit specified by id as void
+// https://www.zoho.com/books/api/v3/vendor-credits/#void-vendor-credit
+func (c *API) VoidVendorCredit(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(VendorCreditsModule, zoho.HTTPPost, c.URL("%s/%s/status/void", VendorCreditsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark vendor credit (%s) as void", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// SubmitVendorCredit will submit the vendor credit specified by id for approval
+// https://www.zoho.com/books/api/v3/vendor-credits/#submit-a-vendor-credit-for-approval
+func (c *API) SubmitVendorCredit(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(VendorCreditsModule, zoho.HTTPPost, c.URL("%s/%s/submit", VendorCreditsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to submit vendor credit (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ApproveVendorCredit will approve the vendor credit specified by id
+// https://www.zoho.com/books/api/v3/vendor-credits/#approve-a-vendor-credit
+func (c *API) ApproveVendorCredit(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(VendorCreditsModule, zoho.HTTPPost, c.URL("%s/%s/approve", VendorCreditsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to approve vendor credit (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ApplyVendorCredit will apply the vendor credit specified by id to the bills of request
+// https://www.zoho.com/books/api/v3/vendor-credits/#apply-credits-to-a-bill
+func (c *API) ApplyVendorCredit(request ApplyVendorCreditRequest, id string) (data ApplyVendorCreditResponse, err error) {
+	endpoint := c.NewEndpoint(VendorCreditsModule, zoho.HTTPPost, c.URL("%s/%s/bills", VendorCreditsModule, id), &ApplyVendorCreditResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to apply vendor credit (%s)", id)); err != nil {
+		return ApplyVendorCreditResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ApplyVendorCreditResponse); ok {
+		return *v, nil
+	}
+
+	return ApplyVendorCreditResponse{}, fmt.Errorf("Data retrieved was not 'ApplyVendorCreditResponse'")
+}
+
+// VendorCreditRequest is the data provided to CreateVendorCredit and UpdateVendorCredit
+type VendorCreditRequest struct {
+	VendorID           string  `json:"vendor_id,omitempty"`
+	VendorCreditNumber string  `json:"vendor_credit_number,omitempty"`
+	ReferenceNumber    string  `json:"reference_number,omitempty"`
+	Date               string  `json:"date,omitempty"`
+	CurrencyID         string  `json:"currency_id,omitempty"`
+	ExchangeRate       float64 `json:"exchange_rate,omitempty"`
+	IsInclusiveTax     bool    `json:"is_inclusive_tax,omitempty"`
+	// BillID is the bill the credit is raised against
+	BillID       string        `json:"bill_id,omitempty"`
+	LineItems    []LineItem    `json:"line_items,omitempty"`
+	Notes        string        `json:"notes,omitempty"`
+	CustomFields []CustomField `json:"custom_fields,omitempty"`
+}
+
+// VendorCredit is a credit received from a vendor, for returned goods or a refund
+type VendorCredit struct {
+	VendorCreditID      string        `json:"vendor_credit_id,omitempty"`
+	VendorCreditNumber  string        `json:"vendor_credit_number,omitempty"`
+	Status              string        `json:"status,omitempty"`
+	VendorID            string        `json:"vendor_id,omitempty"`
+	VendorName          string        `json:"vendor_name,omitempty"`
+	ReferenceNumber     string        `json:"reference_number,omitempty"`
+	Date                string        `json:"date,omitempty"`
+	CurrencyID          string        `json:"currency_id,omitempty"`
+	CurrencyCode        string        `json:"currency_code,omitempty"`
+	ExchangeRate        float64       `json:"exchange_rate,omitempty"`
+	IsInclusiveTax      bool          `json:"is_inclusive_tax,omitempty"`
+	LineItems           []LineItem    `json:"line_items,omitempty"`
+	SubTotal            float64       `json:"sub_total,omitempty"`
+	TaxTotal            float64       `json:"tax_total,omitempty"`
+	Total               float64       `json:"total,omitempty"`
+	Taxes               []Tax         `json:"taxes,omitempty"`
+	TotalCreditsUsed    float64       `json:"total_credits_used,omitempty"`
+	TotalRefundedAmount float64       `json:"total_refunded_amount,omitempty"`
+	Balance             float64       `json:"balance,omitempty"`
+	BillsCredited       []AppliedBill `json:"bills_credited,omitempty"`
+	Notes               string        `json:"notes,omitempty"`
+	CustomFields        []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime         string        `json:"created_time,omitempty"`
+	LastModifiedTime    string        `json:"last_modified_time,omitempty"`
+}
+
+// VendorCreditsResponse is the data returned by ListVendorCredits
+type VendorCreditsResponse struct {
+	Response
+	VendorCredits []VendorCredit `json:"vendor_credits,omitempty"`
+	PageContext   PageContext    `json:"page_context,omitempty"`
+}
+
+// VendorCreditResponse is the data returned by GetVendorCredit, CreateVendorCredit and UpdateVendorCredit
+type VendorCreditResponse struct {
+	Response
+	VendorCredit VendorCredit `json:"vendor_credit,omitempty"`
+}
+
+// ApplyVendorCreditRequest is the data provided to ApplyVendorCredit
+type ApplyVendorCreditRequest struct {
+	Bills []AppliedBill `json:"bills"`
+}
+
+// ApplyVendorCreditResponse is the data returned by ApplyVendorCredit
+type ApplyVendorCreditResponse struct {
+	Response
+	Bills []AppliedBill `json:"bills,omitempty"`
+}
@@ -1 +1,160 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListVendorPayments will return a page of the vendor payments of the organization, filtered and paged with params
+// (eg. 'vendor_id', 'payment_mode', 'date_start', 'date_end', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/vendor-payments/#list-vendor-payments
+func (c *API) ListVendorPayments(params map[string]zoho.Parameter) (data VendorPaymentsResponse, err error) {
+	endpoint := c.ListEndpoint(VendorPaymentsModule, c.URL(VendorPaymentsModule), &VendorPaymentsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list vendor payments"); err != nil {
+		return VendorPaymentsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorPaymentsResponse); ok {
+		return *v, nil
+	}
+
+	return VendorPaymentsResponse{}, fmt.Errorf("Data retrieved was not 'VendorPaymentsResponse'")
+}
+
+// GetVendorPayment will return the vendor payment specified by id
+// https://www.zoho.com/books/api/v3/vendor-payments/#retrieve-a-vendor-payment
+func (c *API) GetVendorPayment(id string) (data VendorPaymentResponse, err error) {
+	endpoint := c.NewEndpoint(VendorPaymentsModule, zoho.HTTPGet, c.URL("%s/%s", VendorPaymentsModule, id), &VendorPaymentResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve vendor payment (%s)", id)); err != nil {
+		return VendorPaymentResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorPaymentResponse); ok {
+		return *v, nil
+	}
+
+	return VendorPaymentResponse{}, fmt.Errorf("Data retrieved was not 'VendorPaymentResponse'")
+}
+
+// CreateVendorPayment will create the vendor payment in request, VendorID and Amount are required.
+// A single payment is applied to several bills by listing them in Bills
+// https://www.zoho.com/books/api/v3/vendor-payments/#create-a-vendor-payment
+func (c *API) CreateVendorPayment(request VendorPaymentRequest) (data VendorPaymentResponse, err error) {
+	endpoint := c.NewEndpoint(VendorPaymentsModule, zoho.HTTPPost, c.URL(VendorPaymentsModule), &VendorPaymentResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create vendor payment"); err != nil {
+		return VendorPaymentResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorPaymentResponse); ok {
+		return *v, nil
+	}
+
+	return VendorPaymentResponse{}, fmt.Errorf("Data retrieved was not 'VendorPaymentResponse'")
+}
+
+// UpdateVendorPayment will update the vendor payment specified by id with request
+// https://www.zoho.com/books/api/v3/vendor-payments/#update-a-vendor-payment
+func (c *API) UpdateVendorPayment(request VendorPaymentRequest, id string) (data VendorPaymentResponse, err error) {
+	endpoint := c.NewEndpoint(VendorPaymentsModule, zoho.HTTPPut, c.URL("%s/%s", VendorPaymentsModule, id), &VendorPaymentResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update vendor payment (%s)", id)); err != nil {
+		return VendorPaymentResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorPaymentResponse); ok {
+		return *v, nil
+	}
+
+	return VendorPaymentResponse{}, fmt.Errorf("Data retrieved was not 'VendorPaymentResponse'")
+}
+
+// DeleteVendorPayment will delete the vendor payment specified by id
+// https://www.zoho.com/books/api/v3/vendor-payments/#delete-a-vendor-payment
+func (c *API) DeleteVendorPayment(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(VendorPaymentsModule, zoho.HTTPDelete, c.URL("%s/%s", VendorPaymentsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete vendor payment (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// VendorPaymentRequest is the data provided to CreateVendorPayment and UpdateVendorPayment
+type VendorPaymentRequest struct {
+	VendorID        string        `json:"vendor_id,omitempty"`
+	Bills           []AppliedBill `json:"bills,omitempty"`
+	Date            string        `json:"date,omitempty"`
+	ExchangeRate    float64       `json:"exchange_rate,omitempty"`
+	PaymentMode     string        `json:"payment_mode,omitempty"`
+	Description     string        `json:"description,omitempty"`
+	ReferenceNumber string        `json:"reference_number,omitempty"`
+	Amount          float64       `json:"amount,omitempty"`
+	// PaidThroughAccountID is the cash or bank account the payment is made from
+	PaidThroughAccountID string        `json:"paid_through_account_id,omitempty"`
+	CheckDetails         *CheckDetails `json:"check_details,omitempty"`
+	CustomFields         []CustomField `json:"custom_fields,omitempty"`
+}
+
+// AppliedBill is the amount of a payment or vendor credit applied to a bill, only BillID and AmountApplied are used in requests
+type AppliedBill struct {
+	BillID        string  `json:"bill_id,omitempty"`
+	BillPaymentID string  `json:"bill_payment_id,omitempty"`
+	AmountApplied float64 `json:"amount_applied,omitempty"`
+	BillNumber    string  `json:"bill_number,omitempty"`
+	Date          string  `json:"date,omitempty"`
+	DueDate       string  `json:"due_date,omitempty"`
+	Total         float64 `json:"total,omitempty"`
+	Balance       float64 `json:"balance,omitempty"`
+}
+
+// CheckDetails are the details of a payment made by check
+type CheckDetails struct {
+	Memo        string `json:"memo,omitempty"`
+	CheckNumber string `json:"check_number,omitempty"`
+}
+
+// VendorPayment is a payment made to a vendor
+type VendorPayment struct {
+	PaymentID              string        `json:"payment_id,omitempty"`
+	PaymentNumber          string        `json:"payment_number,omitempty"`
+	VendorID               string        `json:"vendor_id,omitempty"`
+	VendorName             string        `json:"vendor_name,omitempty"`
+	Bills                  []AppliedBill `json:"bills,omitempty"`
+	PaymentMode            string        `json:"payment_mode,omitempty"`
+	Description            string        `json:"description,omitempty"`
+	Date                   string        `json:"date,omitempty"`
+	ReferenceNumber        string        `json:"reference_number,omitempty"`
+	CurrencyID             string        `json:"currency_id,omitempty"`
+	CurrencyCode           string        `json:"currency_code,omitempty"`
+	ExchangeRate           float64       `json:"exchange_rate,omitempty"`
+	Amount                 float64       `json:"amount,omitempty"`
+	Balance                float64       `json:"balance,omitempty"`
+	PaidThroughAccountID   string        `json:"paid_through_account_id,omitempty"`
+	PaidThroughAccountName string        `json:"paid_through_account_name,omitempty"`
+	CheckDetails           CheckDetails  `json:"check_details,omitempty"`
+	CustomFields           []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime            string        `json:"created_time,omitempty"`
+	LastModifiedTime       string        `json:"last_modified_time,omitempty"`
+}
+
+// VendorPaymentsResponse is the data returned by ListVendorPayments
+type VendorPaymentsResponse struct {
+	Response
+	VendorPayments []VendorPayment `json:"vendorpayments,omitempty"`
+	PageContext    PageContext     `json:"page_context,omitempty"`
+}
+
+// VendorPaymentResponse is the data returned by GetVendorPayment, CreateVendorPayment and UpdateVendorPayment
+type VendorPaymentResponse struct {
+	Response
+	VendorPayment VendorPayment `json:"vendorpayment,omitempty"`
+}
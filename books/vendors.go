@@ -0,0 +1,22 @@
+package books
+
+import (
+	zoho "github.com/iapon/zoho"
+)
+
+// ListVendors will return a page of the vendors of the organization, vendors are the contacts of type VendorContact
+// https://www.zoho.com/books/api/v3/contacts/#list-contacts
+func (c *API) ListVendors(params map[string]zoho.Parameter) (data ContactsResponse, err error) {
+	p := map[string]zoho.Parameter{"contact_type": zoho.Parameter(VendorContact)}
+	for k, v := range params {
+		p[k] = v
+	}
+	return c.ListContacts(p)
+}
+
+// CreateVendor will create the vendor in request, ContactName is required
+// https://www.zoho.com/books/api/v3/contacts/#create-a-contact
+func (c *API) CreateVendor(request ContactRequest) (data ContactResponse, err error) {
+	request.ContactType = VendorContact
+	return c.CreateContact(request)
+}
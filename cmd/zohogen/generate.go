@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Snapshot is the module and field metadata the structs are generated from,
+// it is saved as JSON so generation can be repeated without calling Zoho
+type Snapshot struct {
+	Product string       `json:"product"`
+	Modules []ModuleMeta `json:"modules"`
+	// Fields holds the /settings/fields response of each module, keyed by module API name
+	Fields map[string][]FieldMeta `json:"fields"`
+}
+
+// ModuleMeta is the subset of the /settings/modules metadata used by the generator
+type ModuleMeta struct {
+	APIName       string `json:"api_name"`
+	ModuleName    string `json:"module_name,omitempty"`
+	SingularLabel string `json:"singular_label,omitempty"`
+	PluralLabel   string `json:"plural_label,omitempty"`
+	APISupported  bool   `json:"api_supported,omitempty"`
+	GeneratedType string `json:"generated_type,omitempty"`
+}
+
+// FieldMeta is the subset of the /settings/fields metadata used by the generator
+type FieldMeta struct {
+	APIName     string `json:"api_name"`
+	FieldLabel  string `json:"field_label,omitempty"`
+	DataType    string `json:"data_type"`
+	CustomField bool   `json:"custom_field,omitempty"`
+	ReadOnly    bool   `json:"read_only,omitempty"`
+	Formula     struct {
+		ReturnType string `json:"return_type,omitempty"`
+	} `json:"formula,omitempty"`
+}
+
+// LoadSnapshot reads a snapshot saved with Save
+func LoadSnapshot(path string) (Snapshot, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("Failed to read snapshot '%s': %s", path, err)
+	}
+
+	snap := Snapshot{}
+	if err = json.Unmarshal(b, &snap); err != nil {
+		return Snapshot{}, fmt.Errorf("Failed to decode snapshot '%s': %s", path, err)
+	}
+	return snap, nil
+}
+
+// Save writes the snapshot to path as indented JSON
+func (s Snapshot) Save(path string) error {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(path, b, 0666); err != nil {
+		return fmt.Errorf("Failed to save snapshot '%s': %s", path, err)
+	}
+	return nil
+}
+
+// selectModules returns the named modules, or every API supported module when names is empty
+func (s Snapshot) selectModules(names []string) []ModuleMeta {
+	var selected []ModuleMeta
+	for _, m := range s.Modules {
+		if len(names) == 0 {
+			if m.APISupported {
+				selected = append(selected, m)
+			}
+			continue
+		}
+		for _, n := range names {
+			if strings.EqualFold(strings.TrimSpace(n), m.APIName) {
+				selected = append(selected, m)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// Generate returns the gofmt'd source of a file in package pkg declaring, for each selected module:
+//   - <Name>Module, the Module constant of the module
+//   - <Name>Record, a struct holding a typed field for every field of the module
+//   - <Name>, the list response of the module, usable with ListRecords and GetRecord
+//
+// The field types are referenced from the crm or recruit package. pkg cannot be that package, the generated
+// names would collide with the ones it declares for the standard modules (eg. LeadsModule, Lead).
+func Generate(snap Snapshot, pkg string, names []string) ([]byte, error) {
+	if pkg == snap.Product {
+		return nil, fmt.Errorf("Failed to generate structs, cannot generate into package %s, use another package", pkg)
+	}
+	qual := snap.Product + "."
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by zohogen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package %s\n\n", pkg)
+	fmt.Fprintf(buf, "import %q\n\n", "github.com/iapon/zoho/"+snap.Product)
+
+	modules := snap.selectModules(names)
+	if len(modules) == 0 {
+		return nil, fmt.Errorf("Failed to generate structs, no module was selected")
+	}
+
+	for _, m := range modules {
+		fields, ok := snap.Fields[m.APIName]
+		if !ok {
+			return nil, fmt.Errorf("Failed to generate structs, the snapshot has no fields for %s", m.APIName)
+		}
+
+		name := m.SingularLabel
+		if name == "" {
+			name = m.APIName
+		}
+		name = identifier(name)
+
+		fmt.Fprintf(buf, "// %sModule is the API name of the %s module\n", identifier(m.APIName), m.APIName)
+		fmt.Fprintf(buf, "const %sModule %sModule = %q\n\n", identifier(m.APIName), qual, m.APIName)
+
+		fmt.Fprintf(buf, "// %s is the data returned when retrieving records of the %s module\n", name, m.APIName)
+		fmt.Fprintf(buf, "type %s struct {\n", name)
+		fmt.Fprintf(buf, "Data []%sRecord `json:\"data,omitempty\"`\n", name)
+		fmt.Fprintf(buf, "Info %sPageInfo `json:\"info,omitempty\"`\n", qual)
+		fmt.Fprintf(buf, "}\n\n")
+
+		fmt.Fprintf(buf, "// %sRecord is a single record of the %s module\n", name, m.APIName)
+		fmt.Fprintf(buf, "type %sRecord struct {\n", name)
+		fmt.Fprintf(buf, "ID string `json:\"id,omitempty\"`\n")
+
+		sort.Slice(fields, func(i, j int) bool { return fields[i].APIName < fields[j].APIName })
+		used := map[string]bool{"ID": true}
+		for _, f := range fields {
+			if f.APIName == "" || f.APIName == "id" {
+				continue
+			}
+
+			field := identifier(f.APIName)
+			for i := 2; used[field]; i++ {
+				field = fmt.Sprintf("%s%d", identifier(f.APIName), i)
+			}
+			used[field] = true
+
+			fmt.Fprintf(buf, "%s %s `json:\"%s,omitempty\"`", field, fieldType(f, qual), f.APIName)
+			if f.CustomField {
+				fmt.Fprintf(buf, " // custom field")
+			}
+			fmt.Fprintf(buf, "\n")
+		}
+		fmt.Fprintf(buf, "}\n\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to format generated structs: %s", err)
+	}
+	return src, nil
+}
+
+// fieldType returns the Go type of a field from its metadata data_type
+func fieldType(f FieldMeta, qual string) string {
+	if f.APIName == "Layout" {
+		return qual + "Layout"
+	}
+
+	dataType := f.DataType
+	if dataType == "formula" {
+		dataType = f.Formula.ReturnType
+	}
+
+	switch dataType {
+	case "text":
+		return qual + "SingleLine"
+	case "textarea":
+		return qual + "MultiLine"
+	case "email":
+		return qual + "Email"
+	case "phone":
+		return qual + "Phone"
+	case "picklist":
+		return qual + "PickList"
+	case "multiselectpicklist":
+		return qual + "MultiSelect"
+	case "date":
+		return qual + "Date"
+	case "datetime":
+		return qual + "Time"
+	case "integer":
+		return qual + "Number"
+	case "bigint":
+		return qual + "Long"
+	case "currency":
+		return qual + "Currency"
+	case "double", "decimal":
+		return qual + "Decimal"
+	case "percent":
+		return qual + "Percent"
+	case "boolean":
+		return qual + "Checkbox"
+	case "website":
+		return qual + "URL"
+	case "autonumber":
+		return qual + "AutoNumber"
+	case "ownerlookup":
+		return qual + "Owner"
+	case "lookup", "userlookup":
+		return qual + "Lookup"
+	case "multiselectlookup", "multiuserlookup":
+		return "[]" + qual + "Lookup"
+	case "subform":
+		return "[]map[string]interface{}"
+	default:
+		// fileupload, imageupload, profileimage, rollup_summary, ... have no dedicated type
+		return "interface{}"
+	}
+}
+
+// identifier converts an API name or label such as "Custom_Field__c" into an exported Go identifier "CustomFieldC"
+func identifier(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	b := strings.Builder{}
+	for _, p := range parts {
+		switch strings.ToUpper(p) {
+		case "ID", "URL", "API":
+			b.WriteString(strings.ToUpper(p))
+			continue
+		}
+		r := []rune(p)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+
+	id := b.String()
+	if id == "" || unicode.IsDigit([]rune(id)[0]) {
+		id = "Field" + id
+	}
+	return id
+}
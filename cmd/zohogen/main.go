@@ -0,0 +1,152 @@
+// Command zohogen generates typed record structs for CRM and Recruit modules from their field metadata.
+//
+// The metadata is read from the /settings/modules and /settings/fields endpoints, or from a snapshot
+// previously saved with -save, so custom modules and fields (eg. "Custom_Field__c") get a typed field
+// using the crm/recruit field types instead of being added by hand.
+//
+// Usage:
+//
+//	zohogen -snapshot metadata.json -modules Leads,Projects -package records -out records_gen.go
+//	zohogen -refresh-token ... -client-id ... -client-secret ... -save metadata.json -out records_gen.go
+//
+// It is intended to be used with go generate:
+//
+//	//go:generate go run github.com/iapon/zoho/cmd/zohogen -snapshot metadata.json -package records -out records_gen.go
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+	"github.com/iapon/zoho/crm"
+	"github.com/iapon/zoho/recruit"
+)
+
+func main() {
+	var (
+		product      = flag.String("product", "crm", "the product the metadata belongs to, 'crm' or 'recruit'")
+		snapshotFile = flag.String("snapshot", "", "read the metadata from this snapshot file instead of calling Zoho")
+		saveFile     = flag.String("save", "", "save the metadata retrieved from Zoho to this snapshot file")
+		modules      = flag.String("modules", "", "comma separated module API names to generate, defaults to every API supported module")
+		pkg          = flag.String("package", "records", "the package name of the generated file, it cannot be the package of -product")
+		out          = flag.String("out", "", "the generated file, defaults to stdout")
+
+		tld          = flag.String("tld", "com", "the Zoho TLD, eg. 'com' or 'eu'")
+		tokensFile   = flag.String("tokens", "./.tokens.zoho", "the file holding the saved access and refresh tokens")
+		clientID     = flag.String("client-id", "", "the client ID used to refresh the access token")
+		clientSecret = flag.String("client-secret", "", "the client secret used to refresh the access token")
+		refreshToken = flag.String("refresh-token", "", "a refresh token used to request a new access token")
+	)
+	flag.Parse()
+
+	if *product != "crm" && *product != "recruit" {
+		log.Fatalf("zohogen: unknown product '%s'", *product)
+	}
+	if *pkg == *product {
+		log.Fatalf("zohogen: cannot generate into package %s, the generated names would collide with its own (eg. LeadsModule, Lead)", *product)
+	}
+
+	var names []string
+	if *modules != "" {
+		names = strings.Split(*modules, ",")
+	}
+
+	var snap Snapshot
+	var err error
+	if *snapshotFile != "" {
+		snap, err = LoadSnapshot(*snapshotFile)
+	} else {
+		z := zoho.New()
+		z.SetZohoTLD(*tld)
+		z.SetTokensFile(*tokensFile)
+		z.SetClientID(*clientID)
+		z.SetClientSecret(*clientSecret)
+		if *refreshToken != "" {
+			z.SetRefreshToken(*refreshToken)
+			if err := z.RefreshTokenRequest(); err != nil {
+				log.Fatalf("zohogen: %s", err)
+			}
+		}
+		snap, err = fetchSnapshot(z, *product, names)
+	}
+	if err != nil {
+		log.Fatalf("zohogen: %s", err)
+	}
+	snap.Product = *product
+
+	if *saveFile != "" {
+		if err := snap.Save(*saveFile); err != nil {
+			log.Fatalf("zohogen: %s", err)
+		}
+	}
+
+	src, err := Generate(snap, *pkg, names)
+	if err != nil {
+		log.Fatalf("zohogen: %s", err)
+	}
+
+	if *out == "" {
+		fmt.Print(string(src))
+		return
+	}
+	if err := ioutil.WriteFile(*out, src, 0666); err != nil {
+		log.Fatalf("zohogen: %s", err)
+	}
+}
+
+// fetchSnapshot retrieves the module and field metadata of product, only the named modules are fetched when names is set
+func fetchSnapshot(z *zoho.Zoho, product string, names []string) (Snapshot, error) {
+	snap := Snapshot{Product: product, Fields: map[string][]FieldMeta{}}
+
+	// The responses of both products are decoded into the snapshot types through JSON,
+	// the snapshot only keeps the attributes the generator uses
+	var modules interface{}
+	var err error
+	if product == "crm" {
+		modules, err = crm.New(z).GetModules()
+	} else {
+		modules, err = recruit.New(z).GetAllMetadata()
+	}
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if err = convert(modules, &snap); err != nil {
+		return Snapshot{}, err
+	}
+
+	for _, m := range snap.selectModules(names) {
+		var fields interface{}
+		if product == "crm" {
+			fields, err = crm.New(z).GetFields(crm.Module(m.APIName))
+		} else {
+			fields, err = recruit.New(z).GetFieldsMetadata(map[string]zoho.Parameter{"module": zoho.Parameter(m.APIName)})
+		}
+		if err != nil {
+			return Snapshot{}, err
+		}
+
+		resp := struct {
+			Fields []FieldMeta `json:"fields"`
+		}{}
+		if err = convert(fields, &resp); err != nil {
+			return Snapshot{}, err
+		}
+		snap.Fields[m.APIName] = resp.Fields
+	}
+
+	return snap, nil
+}
+
+// convert copies the JSON representation of src into dst
+func convert(src, dst interface{}) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dst)
+}
@@ -0,0 +1,251 @@
+package crm
+
+import (
+	"fmt"
+	"io"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// GetAttachments returns the attachments of the record ID in module
+// https://www.zoho.com/crm/developer/docs/api/v2/get-attachments.html
+func (c *API) GetAttachments(module Module, ID string, params map[string]zoho.Parameter) (data AttachmentsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "attachments",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/Attachments", c.ZohoTLD, module, ID),
+		Method:       zoho.HTTPGet,
+		ResponseData: &AttachmentsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"fields":   "",
+			"page":     "",
+			"per_page": "200",
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return AttachmentsResponse{}, fmt.Errorf("Failed to retrieve attachments of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*AttachmentsResponse); ok {
+		return *v, nil
+	}
+
+	return AttachmentsResponse{}, fmt.Errorf("Data returned was not 'AttachmentsResponse'")
+}
+
+// AttachmentsResponse is the data returned by GetAttachments
+type AttachmentsResponse struct {
+	Data []struct {
+		ID           string `json:"id,omitempty"`
+		FileName     string `json:"File_Name,omitempty"`
+		Size         string `json:"Size,omitempty"`
+		FileID       string `json:"$file_id,omitempty"`
+		Type         string `json:"$type,omitempty"`
+		LinkURL      string `json:"$link_url,omitempty"`
+		Editable     bool   `json:"$editable,omitempty"`
+		SeModule     string `json:"$se_module,omitempty"`
+		Owner        Owner  `json:"Owner,omitempty"`
+		CreatedBy    Lookup `json:"Created_By,omitempty"`
+		CreatedTime  Time   `json:"Created_Time,omitempty"`
+		ModifiedBy   Lookup `json:"Modified_By,omitempty"`
+		ModifiedTime Time   `json:"Modified_Time,omitempty"`
+		ParentID     Lookup `json:"Parent_Id,omitempty"`
+	} `json:"data,omitempty"`
+	Info PageInfo `json:"info,omitempty"`
+}
+
+// UploadAttachment attaches the file at path file to the record ID in module
+// https://www.zoho.com/crm/developer/docs/api/v2/upload-attachment.html
+func (c *API) UploadAttachment(module Module, ID string, file string) (data AttachmentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:            "attachments",
+		URL:             fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/Attachments", c.ZohoTLD, module, ID),
+		Method:          zoho.HTTPPost,
+		ResponseData:    &AttachmentResponse{},
+		BodyFormat:      zoho.FILE,
+		Attachment:      file,
+		AttachmentField: "file",
+	}
+
+	return c.sendAttachment(&endpoint, module, "Failed to upload attachment")
+}
+
+// UploadAttachmentReader attaches the contents of r to the record ID in module under the name filename
+// https://www.zoho.com/crm/developer/docs/api/v2/upload-attachment.html
+func (c *API) UploadAttachmentReader(module Module, ID string, filename string, r io.Reader) (data AttachmentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:             "attachments",
+		URL:              fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/Attachments", c.ZohoTLD, module, ID),
+		Method:           zoho.HTTPPost,
+		ResponseData:     &AttachmentResponse{},
+		BodyFormat:       zoho.FILE_READER,
+		Attachment:       filename,
+		AttachmentReader: r,
+		AttachmentField:  "file",
+	}
+
+	return c.sendAttachment(&endpoint, module, "Failed to upload attachment")
+}
+
+// UploadAttachmentURL attaches a link to attachmentURL to the record ID in module
+// https://www.zoho.com/crm/developer/docs/api/v2/upload-attachment.html
+func (c *API) UploadAttachmentURL(module Module, ID string, attachmentURL string) (data AttachmentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "attachments",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/Attachments", c.ZohoTLD, module, ID),
+		Method:       zoho.HTTPPost,
+		ResponseData: &AttachmentResponse{},
+		BodyFormat:   zoho.FORM,
+		FormFields: map[string]string{
+			"attachmentUrl": attachmentURL,
+		},
+	}
+
+	return c.sendAttachment(&endpoint, module, "Failed to upload attachment URL")
+}
+
+// DownloadAttachment writes the contents of the attachment attachmentID of the record ID in module to w
+// https://www.zoho.com/crm/developer/docs/api/v2/download-attachments.html
+func (c *API) DownloadAttachment(module Module, ID string, attachmentID string, w io.Writer) error {
+	endpoint := zoho.Endpoint{
+		Name:   "attachments",
+		URL:    fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/Attachments/%s", c.ZohoTLD, module, ID, attachmentID),
+		Method: zoho.HTTPGet,
+	}
+
+	if err := c.Zoho.HTTPDownload(&endpoint, w); err != nil {
+		return fmt.Errorf("Failed to download attachment of %s: %s", module, err)
+	}
+	return nil
+}
+
+// DeleteAttachment deletes the attachment attachmentID of the record ID in module
+// https://www.zoho.com/crm/developer/docs/api/v2/delete-attachments.html
+func (c *API) DeleteAttachment(module Module, ID string, attachmentID string) (data AttachmentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "attachments",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/Attachments/%s", c.ZohoTLD, module, ID, attachmentID),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &AttachmentResponse{},
+	}
+
+	return c.sendAttachment(&endpoint, module, "Failed to delete attachment")
+}
+
+// sendAttachment performs an attachment request and returns its response
+func (c *API) sendAttachment(endpoint *zoho.Endpoint, module Module, failure string) (AttachmentResponse, error) {
+	err := c.Zoho.HTTPRequest(endpoint)
+	if err != nil {
+		return AttachmentResponse{}, fmt.Errorf("%s of %s: %s", failure, module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*AttachmentResponse); ok {
+		return *v, nil
+	}
+
+	return AttachmentResponse{}, fmt.Errorf("Data returned was not 'AttachmentResponse'")
+}
+
+// AttachmentResponse is the data returned when uploading or deleting an attachment
+type AttachmentResponse struct {
+	Data []struct {
+		Code    string `json:"code,omitempty"`
+		Details struct {
+			ID           string `json:"id,omitempty"`
+			CreatedBy    Lookup `json:"Created_By,omitempty"`
+			CreatedTime  Time   `json:"Created_Time,omitempty"`
+			ModifiedBy   Lookup `json:"Modified_By,omitempty"`
+			ModifiedTime Time   `json:"Modified_Time,omitempty"`
+		} `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// GetPhoto writes the photo of the record ID in module to w, only Leads, Contacts, Accounts,
+// Products, Vendors and custom modules have photos
+// https://www.zoho.com/crm/developer/docs/api/v2/download-record-photo.html
+func (c *API) GetPhoto(module Module, ID string, w io.Writer) error {
+	endpoint := zoho.Endpoint{
+		Name:   "photo",
+		URL:    fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/photo", c.ZohoTLD, module, ID),
+		Method: zoho.HTTPGet,
+	}
+
+	if err := c.Zoho.HTTPDownload(&endpoint, w); err != nil {
+		return fmt.Errorf("Failed to retrieve photo of %s: %s", module, err)
+	}
+	return nil
+}
+
+// UploadPhoto sets the image at path file as the photo of the record ID in module
+// https://www.zoho.com/crm/developer/docs/api/v2/upload-record-photo.html
+func (c *API) UploadPhoto(module Module, ID string, file string) (data PhotoResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:            "photo",
+		URL:             fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/photo", c.ZohoTLD, module, ID),
+		Method:          zoho.HTTPPost,
+		ResponseData:    &PhotoResponse{},
+		BodyFormat:      zoho.FILE,
+		Attachment:      file,
+		AttachmentField: "file",
+	}
+
+	return c.sendPhoto(&endpoint, module, "Failed to upload photo")
+}
+
+// UploadPhotoReader sets the image read from r as the photo of the record ID in module
+// https://www.zoho.com/crm/developer/docs/api/v2/upload-record-photo.html
+func (c *API) UploadPhotoReader(module Module, ID string, filename string, r io.Reader) (data PhotoResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:             "photo",
+		URL:              fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/photo", c.ZohoTLD, module, ID),
+		Method:           zoho.HTTPPost,
+		ResponseData:     &PhotoResponse{},
+		BodyFormat:       zoho.FILE_READER,
+		Attachment:       filename,
+		AttachmentReader: r,
+		AttachmentField:  "file",
+	}
+
+	return c.sendPhoto(&endpoint, module, "Failed to upload photo")
+}
+
+// DeletePhoto removes the photo of the record ID in module
+// https://www.zoho.com/crm/developer/docs/api/v2/delete-record-photo.html
+func (c *API) DeletePhoto(module Module, ID string) (data PhotoResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "photo",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/photo", c.ZohoTLD, module, ID),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &PhotoResponse{},
+	}
+
+	return c.sendPhoto(&endpoint, module, "Failed to delete photo")
+}
+
+// sendPhoto performs a photo request and returns its response
+func (c *API) sendPhoto(endpoint *zoho.Endpoint, module Module, failure string) (PhotoResponse, error) {
+	err := c.Zoho.HTTPRequest(endpoint)
+	if err != nil {
+		return PhotoResponse{}, fmt.Errorf("%s of %s: %s", failure, module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*PhotoResponse); ok {
+		return *v, nil
+	}
+
+	return PhotoResponse{}, fmt.Errorf("Data returned was not 'PhotoResponse'")
+}
+
+// PhotoResponse is the data returned when uploading or deleting the photo of a record
+type PhotoResponse struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
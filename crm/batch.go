@@ -0,0 +1,114 @@
+package crm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// BatchOptions controls how InsertRecordsBatched, UpdateRecordsBatched, UpsertRecordsBatched and
+// DeleteRecordsBatched split their input
+type BatchOptions = zoho.BatchOptions
+
+// BatchResult is the merged outcome of a batched write, see zoho.BatchResult
+type BatchResult = zoho.BatchResult
+
+// RecordError is the error reported for a single record of a batched write
+type RecordError = zoho.RecordError
+
+// InsertRecordsBatched inserts any number of records into module, splitting them in requests of at most
+// zoho.MaxRecordsPerRequest records. records must be a slice. The result maps every input index to the new
+// record id or a *RecordError, a failure of one record does not stop the others from being inserted.
+func (c *API) InsertRecordsBatched(module Module, records interface{}, trigger []string, opts BatchOptions) (data BatchResult, err error) {
+	return c.writeRecordsBatched("Failed to insert records", zoho.HTTPPost, fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s", c.ZohoTLD, module),
+		module, records, nil, trigger, opts)
+}
+
+// UpdateRecordsBatched updates any number of records of module, every record must contain its id.
+// See InsertRecordsBatched for how the records are split and the results merged.
+func (c *API) UpdateRecordsBatched(module Module, records interface{}, trigger []string, opts BatchOptions) (data BatchResult, err error) {
+	return c.writeRecordsBatched("Failed to update records", zoho.HTTPPut, fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s", c.ZohoTLD, module),
+		module, records, nil, trigger, opts)
+}
+
+// UpsertRecordsBatched inserts or updates any number of records of module, matching existing records using
+// duplicateFieldsCheck. See InsertRecordsBatched for how the records are split and the results merged.
+func (c *API) UpsertRecordsBatched(module Module, records interface{}, duplicateFieldsCheck []string, trigger []string, opts BatchOptions) (data BatchResult, err error) {
+	params := map[string]zoho.Parameter{
+		"duplicate_field_check": zoho.Parameter(strings.Join(duplicateFieldsCheck, ",")),
+	}
+	return c.writeRecordsBatched("Failed to upsert records", zoho.HTTPPost, fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/upsert", c.ZohoTLD, module),
+		module, records, params, trigger, opts)
+}
+
+// DeleteRecordsBatched deletes any number of records of module by id, sending at most zoho.MaxRecordsPerRequest ids per request
+func (c *API) DeleteRecordsBatched(module Module, ids []string, opts BatchOptions) (data BatchResult, err error) {
+	if len(ids) == 0 {
+		return BatchResult{}, fmt.Errorf("Failed to delete records, must provide at least 1 ID")
+	}
+
+	return zoho.RunBatches(len(ids), opts, func(start, end int) ([]zoho.RecordStatus, error) {
+		endpoint := zoho.Endpoint{
+			Name:         "records",
+			URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s", c.ZohoTLD, module),
+			Method:       zoho.HTTPDelete,
+			ResponseData: &zoho.RecordsStatusResponse{},
+			URLParameters: map[string]zoho.Parameter{
+				"ids": zoho.Parameter(strings.Join(ids[start:end], ",")),
+			},
+			PartialSuccess: true,
+		}
+
+		return c.sendRecordsBatch(&endpoint, module, "Failed to delete records")
+	}), nil
+}
+
+// writeRecordsBatched sends records to url in batches with the given method
+func (c *API) writeRecordsBatched(failure string, method zoho.HTTPMethod, url string, module Module, records interface{}, params map[string]zoho.Parameter, trigger []string, opts BatchOptions) (BatchResult, error) {
+	v := reflect.ValueOf(records)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		return BatchResult{}, fmt.Errorf("%s of %s, records must be a slice", failure, module)
+	}
+
+	return zoho.RunBatches(v.Len(), opts, func(start, end int) ([]zoho.RecordStatus, error) {
+		endpoint := zoho.Endpoint{
+			Name:         "records",
+			URL:          url,
+			Method:       method,
+			ResponseData: &zoho.RecordsStatusResponse{},
+			RequestBody: InsertRecordsData{
+				Data:    v.Slice(start, end).Interface(),
+				Trigger: trigger,
+			},
+			URLParameters:  map[string]zoho.Parameter{},
+			PartialSuccess: true,
+		}
+		for k, p := range params {
+			endpoint.URLParameters[k] = p
+		}
+
+		return c.sendRecordsBatch(&endpoint, module, failure)
+	}), nil
+}
+
+// sendRecordsBatch performs a single batch request and returns the status of each record
+func (c *API) sendRecordsBatch(endpoint *zoho.Endpoint, module Module, failure string) ([]zoho.RecordStatus, error) {
+	err := c.Zoho.HTTPRequest(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%s of %s: %s", failure, module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*zoho.RecordsStatusResponse); ok {
+		if len(v.Data) == 0 && v.Status == "error" {
+			return nil, fmt.Errorf("%s of %s: %s: %s", failure, module, v.Code, v.Message)
+		}
+		return v.Data, nil
+	}
+
+	return nil, fmt.Errorf("Data returned was not 'RecordsStatusResponse'")
+}
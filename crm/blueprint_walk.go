@@ -0,0 +1,169 @@
+package crm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Transition returns the transition of the blueprint matching name or ID
+func (b BlueprintResponse) Transition(name string) (BlueprintTransition, bool) {
+	for _, t := range b.Blueprint.Transitions {
+		if t.ID == name || strings.EqualFold(t.Name, name) {
+			return t, true
+		}
+	}
+	return BlueprintTransition{}, false
+}
+
+// AvailableTransitions returns the transitions the record can currently move through
+func (b BlueprintResponse) AvailableTransitions() []BlueprintTransition {
+	var available []BlueprintTransition
+	for _, t := range b.Blueprint.Transitions {
+		if t.CriteriaMatched {
+			available = append(available, t)
+		}
+	}
+	return available
+}
+
+// MissingFields returns the API names of the mandatory fields of the transition which are not set in data
+func (t BlueprintTransition) MissingFields(data map[string]interface{}) []string {
+	var missing []string
+	for _, f := range t.Fields {
+		if !f.Mandatory {
+			continue
+		}
+		name := f.APIName
+		if name == "" {
+			name = f.PersonalityName
+		}
+		if v, ok := data[name]; !ok || v == nil || v == "" {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// ListTransitions returns the transitions the record ID of module can currently move through
+func (c *API) ListTransitions(module Module, ID string) ([]BlueprintTransition, error) {
+	bp, err := c.GetBlueprint(module, ID)
+	if err != nil {
+		return nil, err
+	}
+	return bp.AvailableTransitions(), nil
+}
+
+// BlueprintStopReason explains why a transition could not be performed
+type BlueprintStopReason string
+
+const (
+	// BlueprintNoTransition - the record has no transition with this name, it may be in another state
+	BlueprintNoTransition BlueprintStopReason = "transition not found"
+	// BlueprintCriteriaNotMatched - the transition exists but the record does not match its criteria
+	BlueprintCriteriaNotMatched BlueprintStopReason = "criteria not matched"
+	// BlueprintMissingFields - mandatory fields of the transition were not provided
+	BlueprintMissingFields BlueprintStopReason = "mandatory fields missing"
+	// BlueprintRequestFailed - Zoho rejected the request
+	BlueprintRequestFailed BlueprintStopReason = "request failed"
+)
+
+// BlueprintError is returned when a record could not move through a transition
+type BlueprintError struct {
+	// Step is the index of the transition in the path given to WalkBlueprint, 0 for PerformTransition
+	Step       int
+	Transition string
+	Reason     BlueprintStopReason
+	// CriteriaMessage is the message of the transition when its criteria are not matched
+	CriteriaMessage string
+	// MissingFields are the API names of the mandatory fields which were not provided
+	MissingFields []string
+	// Available are the names of the transitions the record could move through instead
+	Available []string
+	// Err is the error returned by Zoho when Reason is BlueprintRequestFailed
+	Err error
+}
+
+func (e *BlueprintError) Error() string {
+	msg := fmt.Sprintf("blueprint stopped at step %d (%s): %s", e.Step, e.Transition, e.Reason)
+	switch e.Reason {
+	case BlueprintNoTransition:
+		msg += fmt.Sprintf(", available transitions: %s", strings.Join(e.Available, ", "))
+	case BlueprintCriteriaNotMatched:
+		if e.CriteriaMessage != "" {
+			msg += ": " + e.CriteriaMessage
+		}
+	case BlueprintMissingFields:
+		msg += ": " + strings.Join(e.MissingFields, ", ")
+	case BlueprintRequestFailed:
+		msg += fmt.Sprintf(": %s", e.Err)
+	}
+	return msg
+}
+
+// PerformTransition moves the record ID of module through the transition named transition (or its id),
+// data holds the values of the transition fields. The transition and its mandatory fields are checked
+// before UpdateBlueprint is called, a *BlueprintError is returned when the transition cannot be performed.
+func (c *API) PerformTransition(module Module, ID string, transition string, data map[string]interface{}) (UpdateBlueprintResponse, error) {
+	bp, err := c.GetBlueprint(module, ID)
+	if err != nil {
+		return UpdateBlueprintResponse{}, err
+	}
+	return c.performTransition(bp, module, ID, 0, transition, data)
+}
+
+// BlueprintStep is a transition of the path given to WalkBlueprint with the values of its fields
+type BlueprintStep struct {
+	Transition string
+	Data       map[string]interface{}
+}
+
+// WalkBlueprint moves the record ID of module through every transition of path in order. It returns the number
+// of transitions performed, and a *BlueprintError holding the step where the walk stopped and why.
+func (c *API) WalkBlueprint(module Module, ID string, path []BlueprintStep) (completed int, err error) {
+	for i, step := range path {
+		bp, err := c.GetBlueprint(module, ID)
+		if err != nil {
+			return completed, &BlueprintError{Step: i, Transition: step.Transition, Reason: BlueprintRequestFailed, Err: err}
+		}
+		if _, err = c.performTransition(bp, module, ID, i, step.Transition, step.Data); err != nil {
+			return completed, err
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+// performTransition checks and performs a transition of the blueprint bp of the record
+func (c *API) performTransition(bp BlueprintResponse, module Module, ID string, step int, transition string, data map[string]interface{}) (UpdateBlueprintResponse, error) {
+	t, ok := bp.Transition(transition)
+	if !ok {
+		var available []string
+		for _, a := range bp.AvailableTransitions() {
+			available = append(available, a.Name)
+		}
+		return UpdateBlueprintResponse{}, &BlueprintError{Step: step, Transition: transition, Reason: BlueprintNoTransition, Available: available}
+	}
+	if !t.CriteriaMatched {
+		return UpdateBlueprintResponse{}, &BlueprintError{Step: step, Transition: t.Name, Reason: BlueprintCriteriaNotMatched, CriteriaMessage: t.CriteriaMessage}
+	}
+	if missing := t.MissingFields(data); len(missing) > 0 {
+		return UpdateBlueprintResponse{}, &BlueprintError{Step: step, Transition: t.Name, Reason: BlueprintMissingFields, MissingFields: missing}
+	}
+
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	request := UpdateBlueprintData{}
+	request.Blueprint = make([]struct {
+		TransitionID string                 `json:"transition_id"`
+		Data         map[string]interface{} `json:"data"`
+	}, 1)
+	request.Blueprint[0].TransitionID = t.ID
+	request.Blueprint[0].Data = data
+
+	resp, err := c.UpdateBlueprint(request, module, ID)
+	if err != nil {
+		return UpdateBlueprintResponse{}, &BlueprintError{Step: step, Transition: t.Name, Reason: BlueprintRequestFailed, Err: err}
+	}
+	return resp, nil
+}
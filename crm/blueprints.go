@@ -42,32 +42,39 @@ type BlueprintResponse struct {
 			ID           string `json:"id"`
 			FieldName    string `json:"field_name"`
 		} `json:"process_info"`
-		Transitions []struct {
-			NextTransitions    []string `json:"next_transitions"`
-			PercentPartialSave float64  `json:"percent_partial_save"`
-			Data               struct {
-				Attachments string `json:"Attachments"`
-			} `json:"data"`
-			NextFieldValue  string `json:"next_field_value"`
-			Name            string `json:"name"`
-			CriteriaMatched bool   `json:"criteria_matched"`
-			ID              string `json:"id"`
-			Fields          []struct {
-				DisplayLabel       string `json:"display_label"`
-				Type               string `json:"_type"`
-				DataType           string `json:"data_type"`
-				ColumnName         string `json:"column_name"`
-				PersonalityName    string `json:"personality_name"`
-				ID                 string `json:"id"`
-				TransitionSequence int    `json:"transition_sequence"`
-				Mandatory          bool   `json:"mandatory"`
-				Layouts            string `json:"layouts"`
-			} `json:"fields"`
-			CriteriaMessage string `json:"criteria_message"`
-		} `json:"transitions"`
+		Transitions []BlueprintTransition `json:"transitions"`
 	} `json:"blueprint"`
 }
 
+// BlueprintTransition is a transition of a blueprint, the record can move through it when CriteriaMatched is true
+type BlueprintTransition struct {
+	NextTransitions    []string `json:"next_transitions"`
+	PercentPartialSave float64  `json:"percent_partial_save"`
+	Data               struct {
+		Attachments string `json:"Attachments"`
+	} `json:"data"`
+	NextFieldValue  string           `json:"next_field_value"`
+	Name            string           `json:"name"`
+	CriteriaMatched bool             `json:"criteria_matched"`
+	ID              string           `json:"id"`
+	Fields          []BlueprintField `json:"fields"`
+	CriteriaMessage string           `json:"criteria_message"`
+}
+
+// BlueprintField is a field to fill during a transition
+type BlueprintField struct {
+	DisplayLabel       string `json:"display_label"`
+	APIName            string `json:"api_name"`
+	Type               string `json:"_type"`
+	DataType           string `json:"data_type"`
+	ColumnName         string `json:"column_name"`
+	PersonalityName    string `json:"personality_name"`
+	ID                 string `json:"id"`
+	TransitionSequence int    `json:"transition_sequence"`
+	Mandatory          bool   `json:"mandatory"`
+	Layouts            string `json:"layouts"`
+}
+
 // UpdateBlueprint updates the blueprint specified by ID in the specified module
 // https://www.zoho.com/crm/help/api/v2/#update-blueprint
 func (c *API) UpdateBlueprint(request UpdateBlueprintData, module Module, id string) (data UpdateBlueprintResponse, err error) {
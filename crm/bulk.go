@@ -0,0 +1,181 @@
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// BulkJobState is the state of a bulk read or bulk write job
+type BulkJobState string
+
+const (
+	// BulkJobAdded - the job has been accepted by Zoho
+	BulkJobAdded BulkJobState = "ADDED"
+	// BulkJobQueued - the job is waiting to be processed
+	BulkJobQueued BulkJobState = "QUEUED"
+	// BulkJobInProgress - the job is being processed (bulk read)
+	BulkJobInProgress BulkJobState = "IN PROGRESS"
+	// BulkJobRunning - the job is being processed (bulk write)
+	BulkJobRunning BulkJobState = "INPROGRESS"
+	// BulkJobCompleted - the job has finished and the result can be downloaded
+	BulkJobCompleted BulkJobState = "COMPLETED"
+	// BulkJobFailure - the job failed (bulk read)
+	BulkJobFailure BulkJobState = "FAILURE"
+	// BulkJobFailed - the job failed (bulk write)
+	BulkJobFailed BulkJobState = "FAILED"
+)
+
+// Finished reports whether the job has reached a terminal state
+func (s BulkJobState) Finished() bool {
+	return s == BulkJobCompleted || s == BulkJobFailure || s == BulkJobFailed
+}
+
+// BulkCallback is the URL Zoho will notify once a bulk job has finished
+type BulkCallback struct {
+	URL    string `json:"url"`
+	Method string `json:"method"`
+}
+
+// BulkCallbackPayload is the data posted by Zoho to a BulkCallback URL
+type BulkCallbackPayload struct {
+	JobID     string          `json:"job_id"`
+	Operation string          `json:"operation"`
+	State     BulkJobState    `json:"state"`
+	Query     json.RawMessage `json:"query,omitempty"`
+	Result    json.RawMessage `json:"result,omitempty"`
+}
+
+// BulkCallbackHandler is an http.Handler which receives the notifications sent by Zoho to the
+// callback URL of a bulk job. Provide it in BulkWaitOptions so that waiting for a job does not need to poll.
+type BulkCallbackHandler struct {
+	mu      sync.Mutex
+	waiters map[string][]chan BulkCallbackPayload
+	done    map[string]BulkCallbackPayload
+}
+
+// NewBulkCallbackHandler returns an empty *BulkCallbackHandler ready to be mounted on an http.ServeMux
+func NewBulkCallbackHandler() *BulkCallbackHandler {
+	return &BulkCallbackHandler{
+		waiters: make(map[string][]chan BulkCallbackPayload),
+		done:    make(map[string]BulkCallbackPayload),
+	}
+}
+
+// ServeHTTP decodes the callback sent by Zoho and wakes any caller waiting for the job
+func (h *BulkCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload := BulkCallbackPayload{}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload.JobID == "" {
+		http.Error(w, "invalid bulk callback payload", http.StatusBadRequest)
+		return
+	}
+
+	h.mu.Lock()
+	if waiters, ok := h.waiters[payload.JobID]; ok {
+		for _, ch := range waiters {
+			ch <- payload
+			close(ch)
+		}
+		delete(h.waiters, payload.JobID)
+	} else {
+		h.done[payload.JobID] = payload
+	}
+	h.mu.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// wait returns a channel which receives the callback for jobID, if the callback has already
+// been received the channel is ready immediately. cancel must be called once the caller stops
+// waiting, it removes the channel from the waiters of the job.
+func (h *BulkCallbackHandler) wait(jobID string) (notify <-chan BulkCallbackPayload, cancel func()) {
+	ch := make(chan BulkCallbackPayload, 1)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if payload, ok := h.done[jobID]; ok {
+		delete(h.done, jobID)
+		ch <- payload
+		close(ch)
+		return ch, func() {}
+	}
+	h.waiters[jobID] = append(h.waiters[jobID], ch)
+	return ch, func() { h.cancel(jobID, ch) }
+}
+
+// cancel removes ch from the waiters of jobID, it does nothing when the callback was already delivered
+func (h *BulkCallbackHandler) cancel(jobID string, ch chan BulkCallbackPayload) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	waiters := h.waiters[jobID]
+	for i, w := range waiters {
+		if w == ch {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(h.waiters, jobID)
+	} else {
+		h.waiters[jobID] = waiters
+	}
+}
+
+// BulkWaitOptions controls how WaitForBulkReadJob, WaitForBulkWriteJob and WaitForMassUpdate wait for a job to finish
+type BulkWaitOptions struct {
+	// PollInterval is the delay between status requests, defaults to 30 seconds
+	PollInterval time.Duration
+	// Timeout is the maximum time to wait, zero means wait forever
+	Timeout time.Duration
+	// Callback, if set, is the handler mounted at the callback URL of the job. The status is only
+	// requested when the callback fires or the PollInterval elapses.
+	Callback *BulkCallbackHandler
+}
+
+// waitForBulkJob blocks until status reports a finished state, the callback is received or the timeout elapses
+func waitForBulkJob(jobID string, opts BulkWaitOptions, status func() (BulkJobState, error)) error {
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	var (
+		notify  <-chan BulkCallbackPayload
+		timeout <-chan time.Time
+	)
+	if opts.Callback != nil {
+		var cancel func()
+		notify, cancel = opts.Callback.wait(jobID)
+		defer cancel()
+	}
+	if opts.Timeout > 0 {
+		timer := time.NewTimer(opts.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		state, err := status()
+		if err != nil {
+			return err
+		}
+		if state.Finished() {
+			return nil
+		}
+
+		select {
+		case <-notify:
+			notify = nil
+		case <-ticker.C:
+		case <-timeout:
+			return fmt.Errorf("Timed out waiting for bulk job %s, last state was %s", jobID, state)
+		}
+	}
+}
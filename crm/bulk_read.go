@@ -0,0 +1,562 @@
+package crm
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// CreateBulkReadJob schedules a bulk export of the records of a module, the result is a zipped CSV
+// (or ICS for Events) which can be retrieved with GetBulkReadResult once the job is completed.
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-read/create-job.html
+func (c *API) CreateBulkReadJob(request BulkReadJobData) (data BulkReadJobResponse, err error) {
+	if request.Query.Module == "" {
+		return BulkReadJobResponse{}, fmt.Errorf("Failed to create bulk read job, module is required")
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "bulk_read",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/bulk/v2/read", c.ZohoTLD),
+		Method:       zoho.HTTPPost,
+		ResponseData: &BulkReadJobResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BulkReadJobResponse{}, fmt.Errorf("Failed to create bulk read job for %s: %s", request.Query.Module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BulkReadJobResponse); ok {
+		for _, d := range v.Data {
+			if d.Status != "success" {
+				return *v, fmt.Errorf("Failed to create bulk read job for %s: %s: %s", request.Query.Module, d.Code, d.Message)
+			}
+		}
+		return *v, nil
+	}
+
+	return BulkReadJobResponse{}, fmt.Errorf("Data returned was not 'BulkReadJobResponse'")
+}
+
+// BulkFileType is the format of the file produced by a bulk read job
+type BulkFileType string
+
+const (
+	// BulkFileCSV - comma separated values, supported by every module
+	BulkFileCSV BulkFileType = "csv"
+	// BulkFileICS - iCalendar, only supported by the Events module
+	BulkFileICS BulkFileType = "ics"
+)
+
+// BulkReadJobData is the data provided to CreateBulkReadJob
+type BulkReadJobData struct {
+	Callback *BulkCallback `json:"callback,omitempty"`
+	Query    BulkReadQuery `json:"query"`
+	FileType BulkFileType  `json:"file_type,omitempty"`
+}
+
+// BulkReadQuery selects the records exported by a bulk read job
+type BulkReadQuery struct {
+	Module   Module            `json:"module"`
+	CVID     string            `json:"cvid,omitempty"`
+	Fields   []string          `json:"fields,omitempty"`
+	Criteria *BulkReadCriteria `json:"criteria,omitempty"`
+	// Page selects the block of 200,000 records exported by the job, starting at 1.
+	// ForEachBulkReadPage submits a job for every page.
+	Page int `json:"page,omitempty"`
+}
+
+// BulkReadCriteria is a single condition, or a group of conditions joined by GroupOperator ("and", "or")
+type BulkReadCriteria struct {
+	APIName       string             `json:"api_name,omitempty"`
+	Comparator    string             `json:"comparator,omitempty"`
+	Value         interface{}        `json:"value,omitempty"`
+	GroupOperator string             `json:"group_operator,omitempty"`
+	Group         []BulkReadCriteria `json:"group,omitempty"`
+}
+
+// BulkReadJobResponse is the data returned by CreateBulkReadJob
+type BulkReadJobResponse struct {
+	Data []struct {
+		Status  string `json:"status"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		Details struct {
+			ID        string       `json:"id"`
+			Operation string       `json:"operation"`
+			State     BulkJobState `json:"state"`
+			CreatedBy struct {
+				Name string `json:"name"`
+				ID   string `json:"id"`
+			} `json:"created_by"`
+			CreatedTime Time `json:"created_time"`
+		} `json:"details"`
+	} `json:"data"`
+	Info struct{} `json:"info"`
+}
+
+// GetBulkReadJob returns the details of the bulk read job specified by jobID
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-read/get-job-details.html
+func (c *API) GetBulkReadJob(jobID string) (data BulkReadJob, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "bulk_read",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/bulk/v2/read/%s", c.ZohoTLD, jobID),
+		Method:       zoho.HTTPGet,
+		ResponseData: &BulkReadJobDetailsResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BulkReadJob{}, fmt.Errorf("Failed to retrieve bulk read job (%s): %s", jobID, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BulkReadJobDetailsResponse); ok {
+		if len(v.Data) == 0 {
+			return BulkReadJob{}, fmt.Errorf("Failed to retrieve bulk read job (%s): no data returned", jobID)
+		}
+		return v.Data[0], nil
+	}
+
+	return BulkReadJob{}, fmt.Errorf("Data returned was not 'BulkReadJobDetailsResponse'")
+}
+
+// BulkReadJobDetailsResponse is the data returned by the bulk read job details endpoint
+type BulkReadJobDetailsResponse struct {
+	Data []BulkReadJob `json:"data"`
+}
+
+// BulkReadJob describes a bulk read job, Result is only populated once the job is completed
+type BulkReadJob struct {
+	ID        string        `json:"id"`
+	Operation string        `json:"operation"`
+	State     BulkJobState  `json:"state"`
+	Query     BulkReadQuery `json:"query"`
+	CreatedBy struct {
+		Name string `json:"name"`
+		ID   string `json:"id"`
+	} `json:"created_by"`
+	CreatedTime Time `json:"created_time"`
+	Result      struct {
+		Page        int    `json:"page"`
+		PerPage     int    `json:"per_page"`
+		Count       int    `json:"count"`
+		DownloadURL string `json:"download_url"`
+		// MoreRecords reports that the query matched more records than this page holds,
+		// the next page is exported by a new job with Query.Page incremented
+		MoreRecords bool `json:"more_records"`
+	} `json:"result"`
+	FileType BulkFileType `json:"file_type"`
+}
+
+// WaitForBulkReadJob blocks until the bulk read job specified by jobID is completed or has failed.
+// The job status is polled at opts.PollInterval, when opts.Callback is provided the status is
+// re-checked as soon as Zoho notifies the callback URL.
+func (c *API) WaitForBulkReadJob(jobID string, opts BulkWaitOptions) (data BulkReadJob, err error) {
+	err = waitForBulkJob(jobID, opts, func() (BulkJobState, error) {
+		data, err = c.GetBulkReadJob(jobID)
+		return data.State, err
+	})
+	if err != nil {
+		return data, err
+	}
+
+	if data.State != BulkJobCompleted {
+		return data, fmt.Errorf("Bulk read job %s finished with state %s", jobID, data.State)
+	}
+
+	return data, nil
+}
+
+// ForEachBulkReadPage exports every record matched by the query of request: a job only exports a page of
+// 200,000 records, so a job is created for the page of request.Query.Page (1 when unset), waited for and passed
+// to fn, and while its result has MoreRecords a job is created for the next page. fn typically reads the
+// result with GetBulkReadResult or DownloadBulkReadResult, eg.
+//
+//	err := c.ForEachBulkReadPage(request, crm.BulkWaitOptions{}, func(job crm.BulkReadJob) error {
+//	    leads := crm.Lead{}
+//	    if err := c.GetBulkReadResult(job.ID, &leads); err != nil {
+//	        return err
+//	    }
+//	    // ... process leads.Data
+//	    return nil
+//	})
+func (c *API) ForEachBulkReadPage(request BulkReadJobData, opts BulkWaitOptions, fn func(job BulkReadJob) error) error {
+	if request.Query.Page <= 0 {
+		request.Query.Page = 1
+	}
+
+	for {
+		resp, err := c.CreateBulkReadJob(request)
+		if err != nil {
+			return err
+		}
+		if len(resp.Data) == 0 {
+			return fmt.Errorf("Failed to create bulk read job for %s: no job returned", request.Query.Module)
+		}
+
+		job, err := c.WaitForBulkReadJob(resp.Data[0].Details.ID, opts)
+		if err != nil {
+			return err
+		}
+		if err = fn(job); err != nil {
+			return err
+		}
+
+		if !job.Result.MoreRecords {
+			return nil
+		}
+		request.Query.Page++
+	}
+}
+
+// DownloadBulkReadResult copies the zip archive produced by the bulk read job into w
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-read/download-result.html
+func (c *API) DownloadBulkReadResult(jobID string, w io.Writer) error {
+	endpoint := zoho.Endpoint{
+		Name:   "bulk_read",
+		URL:    fmt.Sprintf("https://www.zohoapis.%s/crm/bulk/v2/read/%s/result", c.ZohoTLD, jobID),
+		Method: zoho.HTTPGet,
+	}
+
+	err := c.Zoho.HTTPDownload(&endpoint, w)
+	if err != nil {
+		return fmt.Errorf("Failed to download bulk read result (%s): %s", jobID, err)
+	}
+
+	return nil
+}
+
+// GetBulkReadResult downloads the result of a completed bulk read job and decodes the CSV rows into out.
+// out must be a pointer to a record type of this package (eg. *crm.Lead, *crm.Deal) or a pointer to a slice of structs.
+// Use DownloadBulkReadResult and DecodeBulkReadCSV to process very large exports without holding them in memory.
+func (c *API) GetBulkReadResult(jobID string, out interface{}) error {
+	return c.bulkReadResult(jobID, func(name string, r io.Reader) error {
+		if strings.EqualFold(filepath.Ext(name), ".ics") {
+			return fmt.Errorf("Bulk read result %s is an ICS file, use GetBulkReadEvents", name)
+		}
+		return UnmarshalBulkReadCSV(r, out)
+	})
+}
+
+// GetBulkReadEvents downloads the result of a completed bulk read job created with BulkFileICS
+// and returns the events it contains
+func (c *API) GetBulkReadEvents(jobID string) (events []BulkReadEvent, err error) {
+	err = c.bulkReadResult(jobID, func(name string, r io.Reader) error {
+		events, err = ParseBulkReadICS(r)
+		return err
+	})
+	return events, err
+}
+
+// bulkReadResult downloads the zip archive of a bulk read job to a temporary file and passes every file it contains to fn
+func (c *API) bulkReadResult(jobID string, fn func(name string, r io.Reader) error) error {
+	tmp, err := ioutil.TempFile("", "zoho-bulk-read-*.zip")
+	if err != nil {
+		return fmt.Errorf("Failed to create temporary file for bulk read result (%s): %s", jobID, err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if err = c.DownloadBulkReadResult(jobID, tmp); err != nil {
+		return err
+	}
+
+	info, err := tmp.Stat()
+	if err != nil {
+		return err
+	}
+
+	archive, err := zip.NewReader(tmp, info.Size())
+	if err != nil {
+		return fmt.Errorf("Failed to open bulk read result (%s): %s", jobID, err)
+	}
+
+	for _, f := range archive.File {
+		rc, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("Failed to open %s in bulk read result (%s): %s", f.Name, jobID, err)
+		}
+		err = fn(f.Name, rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeBulkReadCSV reads the CSV produced by a bulk read job and calls fn for every row,
+// the row is keyed by the field API names found in the header
+func DecodeBulkReadCSV(r io.Reader, fn func(row map[string]string) error) error {
+	reader := csv.NewReader(bufio.NewReader(r))
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to read bulk read CSV header: %s", err)
+	}
+	if len(header) > 0 {
+		// Strip a UTF-8 byte order mark from the first column
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Failed to read bulk read CSV: %s", err)
+		}
+
+		row := make(map[string]string, len(header))
+		for i, name := range header {
+			if i < len(record) {
+				row[name] = record[i]
+			}
+		}
+
+		if err = fn(row); err != nil {
+			return err
+		}
+	}
+}
+
+// UnmarshalBulkReadCSV decodes every row of a bulk read CSV into out, which must be a pointer to a
+// record type with a Data slice (eg. *crm.Lead) or a pointer to a slice of structs. The CSV values are
+// converted to the type of the field with the matching json tag, lookup fields (eg. Owner, Account_Name)
+// receive the exported id, and "Field.name" style columns populate the matching sub field.
+func UnmarshalBulkReadCSV(r io.Reader, out interface{}) error {
+	slice, err := recordsSlice(out)
+	if err != nil {
+		return err
+	}
+	elemType := slice.Type().Elem()
+
+	return DecodeBulkReadCSV(r, func(row map[string]string) error {
+		elem := reflect.New(elemType)
+		if err := decodeBulkRow(row, elem.Interface()); err != nil {
+			return err
+		}
+		slice.Set(reflect.Append(slice, elem.Elem()))
+		return nil
+	})
+}
+
+// recordsSlice returns the settable slice that decoded records are appended to
+func recordsSlice(out interface{}) (reflect.Value, error) {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return reflect.Value{}, fmt.Errorf("Failed to decode records, out must be a non-nil pointer")
+	}
+	v = v.Elem()
+
+	if v.Kind() == reflect.Struct {
+		v = v.FieldByName("Data")
+		if !v.IsValid() {
+			return reflect.Value{}, fmt.Errorf("Failed to decode records, %s has no Data field", reflect.TypeOf(out).Elem())
+		}
+	}
+
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("Failed to decode records, %s is not a slice of structs", v.Type())
+	}
+
+	return v, nil
+}
+
+// decodeBulkRow converts the string values of row into JSON values matching the fields of the struct
+// pointed to by out, and unmarshals them into it
+func decodeBulkRow(row map[string]string, out interface{}) error {
+	fields := jsonFields(reflect.TypeOf(out).Elem())
+	values := map[string]interface{}{}
+
+	for column, raw := range row {
+		if raw == "" {
+			continue
+		}
+
+		name, sub := column, ""
+		if i := strings.Index(column, "."); i > 0 {
+			name, sub = column[:i], column[i+1:]
+		}
+
+		fieldType, ok := fields[name]
+		if !ok {
+			// The CSV header is not always cased like the json tag, eg. "Id" for "id"
+			for jsonName, t := range fields {
+				if strings.EqualFold(jsonName, name) {
+					name, fieldType, ok = jsonName, t, true
+					break
+				}
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		value, err := bulkValue(fieldType, raw)
+		if err != nil {
+			return fmt.Errorf("Failed to decode column %s: %s", column, err)
+		}
+
+		if _, isObj := value.(map[string]interface{}); isObj {
+			obj, found := values[name].(map[string]interface{})
+			if !found {
+				obj = map[string]interface{}{}
+			}
+			if sub == "" {
+				sub = "id"
+			}
+			obj[sub] = raw
+			values[name] = obj
+			continue
+		}
+
+		if sub == "" {
+			values[name] = value
+		}
+	}
+
+	b, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, out)
+}
+
+// jsonFields maps the json names of the fields of t to their types
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// bulkValue converts a raw CSV value into a value which marshals to JSON accepted by t
+func bulkValue(t reflect.Type, raw string) (interface{}, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// Types with their own decoding (Time, Date, ...) receive the value as a JSON string
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return raw, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if i, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, err
+		}
+		return int64(f), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.ParseFloat(raw, 64)
+	case reflect.Bool:
+		return strconv.ParseBool(raw)
+	case reflect.Slice:
+		parts := strings.Split(raw, ";")
+		values := make([]interface{}, 0, len(parts))
+		for _, p := range parts {
+			if p = strings.TrimSpace(p); p != "" {
+				values = append(values, p)
+			}
+		}
+		return values, nil
+	case reflect.Struct:
+		return map[string]interface{}{"id": raw}, nil
+	default:
+		return raw, nil
+	}
+}
+
+// BulkReadEvent is a single VEVENT of an ICS bulk read result, keyed by property name (eg. "SUMMARY", "DTSTART")
+type BulkReadEvent map[string]string
+
+// ParseBulkReadICS reads the VEVENT components of an iCalendar file produced by a bulk read job of the Events module
+func ParseBulkReadICS(r io.Reader) ([]BulkReadEvent, error) {
+	var (
+		events  []BulkReadEvent
+		current BulkReadEvent
+		lines   []string
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		// Unfold continuation lines
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Failed to read bulk read ICS: %s", err)
+	}
+
+	for _, line := range lines {
+		switch line {
+		case "BEGIN:VEVENT":
+			current = BulkReadEvent{}
+			continue
+		case "END:VEVENT":
+			if current != nil {
+				events = append(events, current)
+			}
+			current = nil
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		i := strings.Index(line, ":")
+		if i < 0 {
+			continue
+		}
+		name := line[:i]
+		// Drop property parameters, eg. DTSTART;TZID=Europe/London
+		if j := strings.Index(name, ";"); j >= 0 {
+			name = name[:j]
+		}
+		value := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(line[i+1:])
+		current[strings.ToUpper(name)] = value
+	}
+
+	return events, nil
+}
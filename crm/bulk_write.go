@@ -0,0 +1,588 @@
+package crm
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// BulkWriteOperation is the kind of write performed by a bulk write job
+type BulkWriteOperation string
+
+const (
+	// BulkInsert - every row is inserted as a new record
+	BulkInsert BulkWriteOperation = "insert"
+	// BulkUpdate - rows update the records matched by find_by
+	BulkUpdate BulkWriteOperation = "update"
+	// BulkUpsert - rows update the records matched by find_by, or are inserted when there is no match
+	BulkUpsert BulkWriteOperation = "upsert"
+)
+
+// UploadBulkFile uploads a zipped CSV file to the Zoho file store, the returned file id is used
+// in the resource of CreateBulkWriteJob. orgID is the zgid returned by GetOrganization.
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-write/upload-file.html
+func (c *API) UploadBulkFile(orgID string, filename string, zipped []byte) (data UploadBulkFileResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:            "bulk_write",
+		URL:             fmt.Sprintf("https://content.zohoapis.%s/crm/v2/upload", c.ZohoTLD),
+		Method:          zoho.HTTPPost,
+		ResponseData:    &UploadBulkFileResponse{},
+		BodyFormat:      zoho.FILE_BYTE,
+		Attachment:      filename,
+		AttachmentByte:  zipped,
+		AttachmentField: "file",
+		Headers: map[string]string{
+			"feature":   "bulk-write",
+			"X-CRM-ORG": orgID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return UploadBulkFileResponse{}, fmt.Errorf("Failed to upload bulk file %s: %s", filename, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*UploadBulkFileResponse); ok {
+		if v.Status != "success" {
+			return *v, fmt.Errorf("Failed to upload bulk file %s: %s: %s", filename, v.Code, v.Message)
+		}
+		return *v, nil
+	}
+
+	return UploadBulkFileResponse{}, fmt.Errorf("Data returned was not 'UploadBulkFileResponse'")
+}
+
+// UploadBulkFileResponse is the data returned by UploadBulkFile
+type UploadBulkFileResponse struct {
+	Status  string `json:"status"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Details struct {
+		FileID      string `json:"file_id"`
+		CreatedTime Time   `json:"created_time"`
+	} `json:"details"`
+}
+
+// CreateBulkWriteJob schedules the import of previously uploaded files
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-write/create-job.html
+func (c *API) CreateBulkWriteJob(request BulkWriteJobData) (data BulkWriteJobResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "bulk_write",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/bulk/v2/write", c.ZohoTLD),
+		Method:       zoho.HTTPPost,
+		ResponseData: &BulkWriteJobResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BulkWriteJobResponse{}, fmt.Errorf("Failed to create bulk write job: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BulkWriteJobResponse); ok {
+		if v.Status != "success" {
+			return *v, fmt.Errorf("Failed to create bulk write job: %s: %s", v.Code, v.Message)
+		}
+		return *v, nil
+	}
+
+	return BulkWriteJobResponse{}, fmt.Errorf("Data returned was not 'BulkWriteJobResponse'")
+}
+
+// BulkWriteJobData is the data provided to CreateBulkWriteJob
+type BulkWriteJobData struct {
+	CharacterEncoding string              `json:"character_encoding,omitempty"`
+	Operation         BulkWriteOperation  `json:"operation"`
+	Callback          *BulkCallback       `json:"callback,omitempty"`
+	Resource          []BulkWriteResource `json:"resource"`
+	IgnoreEmpty       bool                `json:"ignore_empty,omitempty"`
+}
+
+// BulkWriteResource maps an uploaded file to a module
+type BulkWriteResource struct {
+	Type          string             `json:"type"`
+	Module        Module             `json:"module"`
+	FileID        string             `json:"file_id"`
+	FindBy        string             `json:"find_by,omitempty"`
+	FieldMappings []BulkFieldMapping `json:"field_mappings,omitempty"`
+}
+
+// BulkFieldMapping maps a CSV column (by zero based index) to a field API name
+type BulkFieldMapping struct {
+	APIName      string      `json:"api_name"`
+	Index        *int        `json:"index,omitempty"`
+	Format       string      `json:"format,omitempty"`
+	FindBy       string      `json:"find_by,omitempty"`
+	DefaultValue interface{} `json:"default_value,omitempty"`
+}
+
+// BulkWriteJobResponse is the data returned by CreateBulkWriteJob
+type BulkWriteJobResponse struct {
+	Status  string `json:"status"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Details struct {
+		ID        string `json:"id"`
+		CreatedBy struct {
+			Name string `json:"name"`
+			ID   string `json:"id"`
+		} `json:"created_by"`
+	} `json:"details"`
+}
+
+// GetBulkWriteJob returns the status of the bulk write job specified by jobID
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-write/get-status.html
+func (c *API) GetBulkWriteJob(jobID string) (data BulkWriteJob, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "bulk_write",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/bulk/v2/write/%s", c.ZohoTLD, jobID),
+		Method:       zoho.HTTPGet,
+		ResponseData: &BulkWriteJob{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BulkWriteJob{}, fmt.Errorf("Failed to retrieve bulk write job (%s): %s", jobID, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BulkWriteJob); ok {
+		return *v, nil
+	}
+
+	return BulkWriteJob{}, fmt.Errorf("Data returned was not 'BulkWriteJob'")
+}
+
+// BulkWriteJob is the data returned by GetBulkWriteJob
+type BulkWriteJob struct {
+	ID                string             `json:"id"`
+	Status            BulkJobState       `json:"status"`
+	Operation         BulkWriteOperation `json:"operation"`
+	CharacterEncoding string             `json:"character_encoding"`
+	Resource          []struct {
+		Status        BulkJobState       `json:"status"`
+		Type          string             `json:"type"`
+		Module        Module             `json:"module"`
+		FindBy        string             `json:"find_by"`
+		FieldMappings []BulkFieldMapping `json:"field_mappings"`
+		File          struct {
+			Status       BulkJobState `json:"status"`
+			Name         string       `json:"name"`
+			AddedCount   int          `json:"added_count"`
+			SkippedCount int          `json:"skipped_count"`
+			UpdatedCount int          `json:"updated_count"`
+			TotalCount   int          `json:"total_count"`
+		} `json:"file"`
+	} `json:"resource"`
+	Result struct {
+		DownloadURL string `json:"download_url"`
+	} `json:"result"`
+	CreatedBy struct {
+		Name string `json:"name"`
+		ID   string `json:"id"`
+	} `json:"created_by"`
+	CreatedTime Time `json:"created_time"`
+}
+
+// WaitForBulkWriteJob blocks until the bulk write job specified by jobID is completed or has failed
+func (c *API) WaitForBulkWriteJob(jobID string, opts BulkWaitOptions) (data BulkWriteJob, err error) {
+	err = waitForBulkJob(jobID, opts, func() (BulkJobState, error) {
+		data, err = c.GetBulkWriteJob(jobID)
+		return data.Status, err
+	})
+	if err != nil {
+		return data, err
+	}
+
+	if data.Status != BulkJobCompleted {
+		return data, fmt.Errorf("Bulk write job %s finished with status %s", jobID, data.Status)
+	}
+
+	return data, nil
+}
+
+// DownloadBulkWriteResult copies the zipped result log found at the download_url of a completed job into w
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-write/download-result.html
+func (c *API) DownloadBulkWriteResult(downloadURL string, w io.Writer) error {
+	endpoint := zoho.Endpoint{
+		Name:   "bulk_write",
+		URL:    downloadURL,
+		Method: zoho.HTTPGet,
+	}
+
+	err := c.Zoho.HTTPDownload(&endpoint, w)
+	if err != nil {
+		return fmt.Errorf("Failed to download bulk write result: %s", err)
+	}
+
+	return nil
+}
+
+// BulkWriteOptions is the data provided to BulkWriteRecords
+type BulkWriteOptions struct {
+	// OrgID is the zgid of the organization, it is retrieved with GetOrganization when empty
+	OrgID string
+	// FindBy is the unique field used to match existing records for updates and upserts (eg. "id", "Email")
+	FindBy string
+	// Callback is notified by Zoho once the job has finished
+	Callback *BulkCallback
+	// Wait controls how the job is waited for
+	Wait BulkWaitOptions
+}
+
+// BulkWriteResult is the data returned by BulkWriteRecords
+type BulkWriteResult struct {
+	Job  BulkWriteJob
+	Rows []BulkWriteRow
+}
+
+// Failed returns the rows which were not written
+func (r BulkWriteResult) Failed() []BulkWriteRow {
+	var failed []BulkWriteRow
+	for _, row := range r.Rows {
+		if !row.Success() {
+			failed = append(failed, row)
+		}
+	}
+	return failed
+}
+
+// BulkWriteRow is the outcome of a single input record, Index is its position in the slice
+// provided to BulkWriteRecords
+type BulkWriteRow struct {
+	Index    int
+	Status   string
+	RecordID string
+	Errors   string
+}
+
+// Success reports whether the record was added or updated
+func (r BulkWriteRow) Success() bool {
+	return r.Status == "ADDED" || r.Status == "UPDATED"
+}
+
+// BulkWriteRecords writes records to the module using the Bulk Write API. records must be a slice of structs,
+// or a pointer to a record type of this package with a Data slice (eg. *crm.Lead). Only the fields which are set
+// on at least one record are sent, lookup fields are matched by id. The call blocks until the job has finished
+// and returns the outcome of every record.
+func (c *API) BulkWriteRecords(module Module, operation BulkWriteOperation, records interface{}, opts BulkWriteOptions) (data BulkWriteResult, err error) {
+	if operation != BulkInsert && opts.FindBy == "" {
+		return BulkWriteResult{}, fmt.Errorf("Failed to bulk %s records of %s, FindBy is required", operation, module)
+	}
+
+	if opts.OrgID == "" {
+		org, err := c.GetOrganization()
+		if err != nil {
+			return BulkWriteResult{}, err
+		}
+		if len(org.Org) == 0 {
+			return BulkWriteResult{}, fmt.Errorf("Failed to bulk %s records of %s, organization was not found", operation, module)
+		}
+		opts.OrgID = org.Org[0].Zgid
+	}
+
+	zipped, mappings, count, err := encodeBulkWriteCSV(module, records)
+	if err != nil {
+		return BulkWriteResult{}, err
+	}
+	if count == 0 {
+		return BulkWriteResult{}, nil
+	}
+
+	upload, err := c.UploadBulkFile(opts.OrgID, fmt.Sprintf("%s.zip", module), zipped)
+	if err != nil {
+		return BulkWriteResult{}, err
+	}
+
+	job, err := c.CreateBulkWriteJob(BulkWriteJobData{
+		Operation: operation,
+		Callback:  opts.Callback,
+		Resource: []BulkWriteResource{{
+			Type:          "data",
+			Module:        module,
+			FileID:        upload.Details.FileID,
+			FindBy:        opts.FindBy,
+			FieldMappings: mappings,
+		}},
+	})
+	if err != nil {
+		return BulkWriteResult{}, err
+	}
+
+	data.Job, err = c.WaitForBulkWriteJob(job.Details.ID, opts.Wait)
+	if err != nil {
+		return data, err
+	}
+
+	data.Rows, err = c.bulkWriteRows(data.Job.Result.DownloadURL)
+	return data, err
+}
+
+// bulkWriteRows downloads the result log of a job and returns the status of every row
+func (c *API) bulkWriteRows(downloadURL string) ([]BulkWriteRow, error) {
+	var buf bytes.Buffer
+	if err := c.DownloadBulkWriteResult(downloadURL, &buf); err != nil {
+		return nil, err
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open bulk write result: %s", err)
+	}
+
+	var rows []BulkWriteRow
+	for _, f := range archive.File {
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to open %s in bulk write result: %s", f.Name, err)
+		}
+
+		err = DecodeBulkReadCSV(rc, func(row map[string]string) error {
+			rows = append(rows, BulkWriteRow{
+				Index:    len(rows),
+				Status:   row["STATUS"],
+				RecordID: row["RECORD_ID"],
+				Errors:   row["ERRORS"],
+			})
+			return nil
+		})
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rows, nil
+}
+
+// encodeBulkWriteCSV converts records into a zipped CSV and the field mappings describing its columns
+func encodeBulkWriteCSV(module Module, records interface{}) (zipped []byte, mappings []BulkFieldMapping, count int, err error) {
+	v := reflect.ValueOf(records)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		v = v.FieldByName("Data")
+	}
+	if !v.IsValid() || v.Kind() != reflect.Slice {
+		return nil, nil, 0, fmt.Errorf("Failed to encode records of %s, expected a slice of structs", module)
+	}
+
+	elemType := v.Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, nil, 0, fmt.Errorf("Failed to encode records of %s, expected a slice of structs", module)
+	}
+
+	// Encode every record into a map of field API name to CSV value
+	rows := make([]map[string]string, 0, v.Len())
+	used := map[string]bool{}
+	for i := 0; i < v.Len(); i++ {
+		elem := reflect.Indirect(v.Index(i))
+		if !elem.IsValid() {
+			rows = append(rows, map[string]string{})
+			continue
+		}
+		row, err := bulkRow(elem)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("Failed to encode record %d of %s: %s", i, module, err)
+		}
+		for name := range row {
+			used[name] = true
+		}
+		rows = append(rows, row)
+	}
+
+	// Keep the column order of the struct
+	var columns []string
+	lookups := map[string]bool{}
+	for i := 0; i < elemType.NumField(); i++ {
+		f := elemType.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if used[name] {
+			columns = append(columns, name)
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			lookups[name] = ft.Kind() == reflect.Struct && !reflect.PtrTo(ft).Implements(jsonMarshalerType)
+		}
+	}
+
+	var csvBuf bytes.Buffer
+	w := csv.NewWriter(&csvBuf)
+	if err = w.Write(columns); err != nil {
+		return nil, nil, 0, err
+	}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, name := range columns {
+			record[i] = row[name]
+		}
+		if err = w.Write(record); err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	w.Flush()
+	if err = w.Error(); err != nil {
+		return nil, nil, 0, err
+	}
+
+	for i, name := range columns {
+		index := i
+		mapping := BulkFieldMapping{APIName: name, Index: &index}
+		if lookups[name] {
+			mapping.FindBy = "id"
+		}
+		mappings = append(mappings, mapping)
+	}
+
+	var zipBuf bytes.Buffer
+	archive := zip.NewWriter(&zipBuf)
+	f, err := archive.Create(fmt.Sprintf("%s.csv", module))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if _, err = io.Copy(f, &csvBuf); err != nil {
+		return nil, nil, 0, err
+	}
+	if err = archive.Close(); err != nil {
+		return nil, nil, 0, err
+	}
+
+	return zipBuf.Bytes(), mappings, len(rows), nil
+}
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// bulkRow converts the writable fields of a record into CSV values keyed by field API name. Unset Optional
+// fields, nil pointers and empty lookups are skipped, explicit zero values such as false or 0 are written.
+// System fields (prefixed with '$') are skipped.
+func bulkRow(elem reflect.Value) (map[string]string, error) {
+	row := map[string]string{}
+	t := elem.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || strings.HasPrefix(name, "$") {
+			continue
+		}
+
+		fv := elem.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		if o, ok := fv.Interface().(zoho.Settable); ok && !o.IsSet() {
+			continue
+		}
+		if (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface) && fv.IsNil() {
+			continue
+		}
+		if fv.Kind() == reflect.Struct && fv.IsZero() {
+			continue
+		}
+		if fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface {
+			fv = fv.Elem()
+		}
+
+		value, err := bulkCSVValue(fv)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %s", name, err)
+		}
+		if value != "" {
+			row[name] = value
+		}
+	}
+
+	return row, nil
+}
+
+// bulkCSVValue formats a single field value for the bulk write CSV
+func bulkCSVValue(v reflect.Value) (string, error) {
+	if reflect.PtrTo(v.Type()).Implements(jsonMarshalerType) || v.Type().Implements(jsonMarshalerType) {
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		b, err := json.Marshal(ptr.Interface())
+		if err != nil {
+			return "", err
+		}
+		var s interface{}
+		if err = json.Unmarshal(b, &s); err != nil {
+			return "", err
+		}
+		return bulkJSONValue(s)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	case reflect.Slice:
+		parts := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			p, err := bulkCSVValue(reflect.Indirect(v.Index(i)))
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, p)
+		}
+		return strings.Join(parts, ";"), nil
+	case reflect.Struct:
+		// Lookups are written by id
+		if id := v.FieldByName("ID"); id.IsValid() && id.Kind() == reflect.String {
+			return id.String(), nil
+		}
+		return "", fmt.Errorf("unsupported struct type %s", v.Type())
+	default:
+		return fmt.Sprint(v.Interface()), nil
+	}
+}
+
+// bulkJSONValue formats a decoded JSON value for the bulk write CSV, the way bulkCSVValue formats the Go value
+func bulkJSONValue(s interface{}) (string, error) {
+	switch s := s.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return s, nil
+	case bool:
+		return strconv.FormatBool(s), nil
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64), nil
+	case []interface{}:
+		parts := make([]string, 0, len(s))
+		for _, e := range s {
+			p, err := bulkJSONValue(e)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, p)
+		}
+		return strings.Join(parts, ";"), nil
+	case map[string]interface{}:
+		// Lookups are written by id
+		if id, ok := s["id"]; ok {
+			return bulkJSONValue(id)
+		}
+		return "", fmt.Errorf("unsupported object without an id")
+	default:
+		return fmt.Sprint(s), nil
+	}
+}
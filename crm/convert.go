@@ -0,0 +1,238 @@
+package crm
+
+import (
+	"bytes"
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ID returns the id of the record of module the lead was converted to, or an empty string
+func (l ConvertedLead) ID(module Module) string {
+	switch module {
+	case ContactsModule:
+		return l.Contacts
+	case AccountsModule:
+		return l.Accounts
+	case DealsModule, PotentialsModule:
+		return l.Deals
+	}
+	return ""
+}
+
+// ConvertLeadOptions is the data provided to ConvertLeadRecord
+type ConvertLeadOptions struct {
+	ConvertLeadRequest
+	// FetchRecords retrieves the Contact, Account and Deal created by the conversion
+	FetchRecords bool
+	// CopyNotesTo are the modules of the converted records (Contacts, Accounts, Deals) the notes of the lead are copied to
+	CopyNotesTo []Module
+	// CopyAttachmentsTo are the modules of the converted records the attachments of the lead are copied to
+	CopyAttachmentsTo []Module
+}
+
+// ConvertLeadResult is the data returned by ConvertLeadRecord
+type ConvertLeadResult struct {
+	LeadID string
+	ConvertedLead
+	// Contact, Account and Deal are only set when FetchRecords was requested and the record was created
+	Contact map[string]interface{}
+	Account map[string]interface{}
+	Deal    map[string]interface{}
+	// NotesCopied and AttachmentsCopied count the copies made for every module of CopyNotesTo and CopyAttachmentsTo
+	NotesCopied       int
+	AttachmentsCopied int
+}
+
+// ConvertLeadRecord converts the Lead specified by ID as described by opts. The notes and attachments of the lead are
+// read before the conversion, so they can be copied to the converted records even when Zoho moves them to the Contact.
+// When the conversion succeeded but a following step failed, the returned result holds the ids of the converted records.
+func (c *API) ConvertLeadRecord(ID string, opts ConvertLeadOptions) (data ConvertLeadResult, err error) {
+	data.LeadID = ID
+
+	var notes NotesResponse
+	if len(opts.CopyNotesTo) > 0 {
+		if notes, err = c.recordNotes(LeadsModule, ID); err != nil {
+			return data, err
+		}
+	}
+
+	var files []attachmentCopy
+	if len(opts.CopyAttachmentsTo) > 0 {
+		if files, err = c.readAttachments(LeadsModule, ID); err != nil {
+			return data, err
+		}
+	}
+
+	resp, err := c.ConvertLead(ConvertLeadData{Data: []ConvertLeadRequest{opts.ConvertLeadRequest}}, ID)
+	if err != nil {
+		return data, err
+	}
+	if len(resp.Data) == 0 {
+		return data, fmt.Errorf("Failed to convert record of %s: no records returned", LeadsModule)
+	}
+	data.ConvertedLead = resp.Data[0]
+
+	if opts.FetchRecords {
+		for _, r := range []struct {
+			module Module
+			record *map[string]interface{}
+		}{
+			{ContactsModule, &data.Contact},
+			{AccountsModule, &data.Account},
+			{DealsModule, &data.Deal},
+		} {
+			if data.ID(r.module) == "" {
+				continue
+			}
+			if *r.record, err = c.getRecordMap(r.module, data.ID(r.module)); err != nil {
+				return data, err
+			}
+		}
+	}
+
+	for _, module := range opts.CopyNotesTo {
+		if data.ID(module) == "" {
+			continue
+		}
+		n, err := c.copyNotes(notes, module, data.ID(module))
+		data.NotesCopied += n
+		if err != nil {
+			return data, err
+		}
+	}
+
+	for _, module := range opts.CopyAttachmentsTo {
+		if data.ID(module) == "" {
+			continue
+		}
+		n, err := c.writeAttachments(files, module, data.ID(module))
+		data.AttachmentsCopied += n
+		if err != nil {
+			return data, err
+		}
+	}
+
+	return data, nil
+}
+
+// recordMaps is a page of records of any module
+type recordMaps struct {
+	Data []map[string]interface{} `json:"data,omitempty"`
+	Info PageInfo                 `json:"info,omitempty"`
+}
+
+// getRecordMap returns every field of the record ID of module
+func (c *API) getRecordMap(module Module, ID string) (map[string]interface{}, error) {
+	v, err := c.GetRecord(&recordMaps{}, module, ID)
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := v.(*recordMaps); ok && len(r.Data) > 0 {
+		return r.Data[0], nil
+	}
+	return nil, fmt.Errorf("Failed to retrieve record %s of %s: no record returned", ID, module)
+}
+
+// recordNotes returns every note of the record ID of module
+func (c *API) recordNotes(module Module, ID string) (data NotesResponse, err error) {
+	for page := 1; ; page++ {
+		v, err := c.ListRelatedRecords(&NotesResponse{}, module, ID, NotesRelatedList, map[string]zoho.Parameter{
+			"page": zoho.Parameter(fmt.Sprint(page)),
+		})
+		if err != nil {
+			return data, err
+		}
+		notes := v.(*NotesResponse)
+		data.Data = append(data.Data, notes.Data...)
+		if !notes.Info.MoreRecords {
+			return data, nil
+		}
+	}
+}
+
+// copyNotes creates a copy of every note of notes on the record ID of module, and returns the number of notes created
+func (c *API) copyNotes(notes NotesResponse, module Module, ID string) (int, error) {
+	// a request holds at most 100 notes
+	created := 0
+	for start := 0; start < len(notes.Data); start += 100 {
+		end := start + 100
+		if end > len(notes.Data) {
+			end = len(notes.Data)
+		}
+
+		request := CreateRecordNoteData{}
+		request.Data = make([]struct {
+			NoteTitle   string `json:"Note_Title,omitempty"`
+			NoteContent string `json:"Note_Content,omitempty"`
+		}, end-start)
+		for i, n := range notes.Data[start:end] {
+			request.Data[i].NoteTitle = n.NoteTitle
+			request.Data[i].NoteContent = n.NoteContent
+		}
+
+		resp, err := c.CreateRecordNote(request, module, ID)
+		if err != nil {
+			return created, fmt.Errorf("Failed to copy notes to %s: %s", module, err)
+		}
+		for _, r := range resp.Data {
+			if r.Status != "success" {
+				return created, fmt.Errorf("Failed to copy notes to %s: %s: %s", module, r.Code, r.Message)
+			}
+			created++
+		}
+	}
+	return created, nil
+}
+
+// attachmentCopy is an attachment read into memory, link attachments only hold their URL
+type attachmentCopy struct {
+	name    string
+	link    string
+	content []byte
+}
+
+// readAttachments downloads every attachment of the record ID of module
+func (c *API) readAttachments(module Module, ID string) ([]attachmentCopy, error) {
+	var files []attachmentCopy
+	for page := 1; ; page++ {
+		attachments, err := c.GetAttachments(module, ID, map[string]zoho.Parameter{
+			"page": zoho.Parameter(fmt.Sprint(page)),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range attachments.Data {
+			if a.LinkURL != "" {
+				files = append(files, attachmentCopy{name: a.FileName, link: a.LinkURL})
+				continue
+			}
+			b := bytes.Buffer{}
+			if err = c.DownloadAttachment(module, ID, a.ID, &b); err != nil {
+				return nil, err
+			}
+			files = append(files, attachmentCopy{name: a.FileName, content: b.Bytes()})
+		}
+
+		if !attachments.Info.MoreRecords {
+			return files, nil
+		}
+	}
+}
+
+// writeAttachments uploads files to the record ID of module, and returns the number of attachments created
+func (c *API) writeAttachments(files []attachmentCopy, module Module, ID string) (int, error) {
+	for i, f := range files {
+		var err error
+		if f.link != "" {
+			_, err = c.UploadAttachmentURL(module, ID, f.link)
+		} else {
+			_, err = c.UploadAttachmentReader(module, ID, f.name, bytes.NewReader(f.content))
+		}
+		if err != nil {
+			return i, fmt.Errorf("Failed to copy attachment %s to %s: %s", f.name, module, err)
+		}
+	}
+	return len(files), nil
+}
@@ -35,7 +35,8 @@ const (
 // the exposed methods are primarily access to CRM modules which provide access to CRM Methods
 type API struct {
 	*zoho.Zoho
-	id string
+	id   string
+	meta metadataCache
 }
 
 // New returns a *crm.API with the provided zoho.Zoho as an embedded field
@@ -0,0 +1,109 @@
+package crm
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// GetFields returns the metadata of every field of the specified module, including custom fields.
+// The response is cached, see SetMetadataTTL.
+// https://www.zoho.com/crm/developer/docs/api/v2/field-meta.html
+func (c *API) GetFields(module Module) (data FieldsResponse, err error) {
+	key := "fields/" + string(module)
+	if v, ok := c.meta.get(key); ok {
+		return v.(FieldsResponse), nil
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "fields",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/fields", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &FieldsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return FieldsResponse{}, fmt.Errorf("Failed to retrieve fields of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*FieldsResponse); ok {
+		c.meta.set(key, *v)
+		return *v, nil
+	}
+
+	return FieldsResponse{}, fmt.Errorf("Data retrieved was not 'FieldsResponse'")
+}
+
+// IsReadOnly reports whether the value of the field cannot be set through the API: the read only fields and
+// the fields computed by Zoho (formula, auto-number, rollup summary, record image)
+func (f Field) IsReadOnly() bool {
+	if f.ReadOnly || f.FieldReadOnly {
+		return true
+	}
+	switch f.DataType {
+	case "formula", "autonumber", "rollup_summary", "profileimage":
+		return true
+	}
+	return false
+}
+
+// FieldsResponse is the data returned by GetFields
+type FieldsResponse struct {
+	Fields []Field `json:"fields,omitempty"`
+}
+
+// Field is the metadata of a single module field
+type Field struct {
+	ID              string `json:"id,omitempty"`
+	APIName         string `json:"api_name,omitempty"`
+	FieldLabel      string `json:"field_label,omitempty"`
+	DisplayLabel    string `json:"display_label,omitempty"`
+	DataType        string `json:"data_type,omitempty"`
+	JSONType        string `json:"json_type,omitempty"`
+	Length          int    `json:"length,omitempty"`
+	DecimalPlace    int    `json:"decimal_place,omitempty"`
+	CustomField     bool   `json:"custom_field,omitempty"`
+	Visible         bool   `json:"visible,omitempty"`
+	ReadOnly        bool   `json:"read_only,omitempty"`
+	FieldReadOnly   bool   `json:"field_read_only,omitempty"`
+	SystemMandatory bool   `json:"system_mandatory,omitempty"`
+	// Required is only returned for the fields of a layout section
+	Required bool `json:"required,omitempty"`
+	ViewType struct {
+		View        bool `json:"view,omitempty"`
+		Edit        bool `json:"edit,omitempty"`
+		Create      bool `json:"create,omitempty"`
+		QuickCreate bool `json:"quick_create,omitempty"`
+	} `json:"view_type,omitempty"`
+	PickListValues []PickListValue `json:"pick_list_values,omitempty"`
+	Lookup         struct {
+		APIName      string `json:"api_name,omitempty"`
+		DisplayLabel string `json:"display_label,omitempty"`
+		ID           string `json:"id,omitempty"`
+		// Module is the api name of the looked up module, newer versions return an object
+		Module interface{} `json:"module,omitempty"`
+	} `json:"lookup,omitempty"`
+	Formula struct {
+		ReturnType string `json:"return_type,omitempty"`
+		Expression string `json:"expression,omitempty"`
+	} `json:"formula,omitempty"`
+	Currency struct {
+		RoundingOption string `json:"rounding_option,omitempty"`
+		Precision      int    `json:"precision,omitempty"`
+	} `json:"currency,omitempty"`
+	AutoNumber struct {
+		Prefix string `json:"prefix,omitempty"`
+		Suffix string `json:"suffix,omitempty"`
+	} `json:"auto_number,omitempty"`
+}
+
+// PickListValue is an option of a picklist or multiselect picklist field
+type PickListValue struct {
+	DisplayValue   string `json:"display_value,omitempty"`
+	ActualValue    string `json:"actual_value,omitempty"`
+	SequenceNumber int    `json:"sequence_number,omitempty"`
+}
@@ -0,0 +1,139 @@
+package crm
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// MassUpdateRecords schedules the update of the field values in request on every record selected by request,
+// with the ids of the records or with a custom view and criteria. The returned job id is used with
+// GetMassUpdateStatus and WaitForMassUpdate.
+// https://www.zoho.com/crm/developer/docs/api/v2/mass-update-records.html
+func (c *API) MassUpdateRecords(request MassUpdateData, module Module) (data MassUpdateResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "mass update",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/actions/mass_update", c.ZohoTLD, module),
+		Method:       zoho.HTTPPost,
+		ResponseData: &MassUpdateResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return MassUpdateResponse{}, fmt.Errorf("Failed to mass update records of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*MassUpdateResponse); ok {
+		return *v, nil
+	}
+
+	return MassUpdateResponse{}, fmt.Errorf("Data returned was not 'MassUpdateResponse'")
+}
+
+// MassUpdateData is the data provided to MassUpdateRecords. Data is a slice holding a single record with the
+// values to set, either IDs or CVID (optionally with Criteria and Territory) select the records to update.
+type MassUpdateData struct {
+	Data      interface{}          `json:"data"`
+	IDs       []string             `json:"ids,omitempty"`
+	CVID      string               `json:"cvid,omitempty"`
+	Criteria  []MassUpdateCriteria `json:"criteria,omitempty"`
+	Territory *MassUpdateTerritory `json:"territory,omitempty"`
+	// OverWrite replaces the values of multi-select fields rather than appending to them
+	OverWrite bool `json:"over_write,omitempty"`
+}
+
+// MassUpdateCriteria filters the records of the custom view of a mass update
+type MassUpdateCriteria struct {
+	APIName string `json:"api_name"`
+	// Comparator is eg. "equal", "not_equal", "contains", "starts_with", "greater_than"
+	Comparator string      `json:"comparator"`
+	Value      interface{} `json:"value"`
+}
+
+// MassUpdateTerritory restricts the records of the custom view of a mass update to a territory
+type MassUpdateTerritory struct {
+	ID           string `json:"id"`
+	IncludeChild bool   `json:"include_child,omitempty"`
+}
+
+// MassUpdateResponse is the data returned by MassUpdateRecords
+type MassUpdateResponse struct {
+	Data []struct {
+		Status  string `json:"status,omitempty"`
+		Code    string `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+		Details struct {
+			JobID string `json:"job_id,omitempty"`
+		} `json:"details,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// JobID returns the id of the scheduled mass update job
+func (r MassUpdateResponse) JobID() string {
+	if len(r.Data) == 0 {
+		return ""
+	}
+	return r.Data[0].Details.JobID
+}
+
+// GetMassUpdateStatus returns the status of the mass update job jobID of module
+// https://www.zoho.com/crm/developer/docs/api/v2/mass-update-records.html
+func (c *API) GetMassUpdateStatus(module Module, jobID string) (data MassUpdateJob, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "mass update",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/actions/mass_update", c.ZohoTLD, module),
+		Method:       zoho.HTTPGet,
+		ResponseData: &MassUpdateStatusResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"job_id": zoho.Parameter(jobID),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return MassUpdateJob{}, fmt.Errorf("Failed to retrieve mass update job %s of %s: %s", jobID, module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*MassUpdateStatusResponse); ok {
+		if len(v.Data) == 0 {
+			return MassUpdateJob{}, fmt.Errorf("Failed to retrieve mass update job %s of %s: no job returned", jobID, module)
+		}
+		return v.Data[0], nil
+	}
+
+	return MassUpdateJob{}, fmt.Errorf("Data returned was not 'MassUpdateStatusResponse'")
+}
+
+// MassUpdateStatusResponse is the data returned when requesting the status of a mass update job
+type MassUpdateStatusResponse struct {
+	Data []MassUpdateJob `json:"data,omitempty"`
+}
+
+// MassUpdateJob is the status of a mass update job, Status is one of SCHEDULED, RUNNING, COMPLETED or FAILED
+type MassUpdateJob struct {
+	Status          BulkJobState `json:"Status,omitempty"`
+	TotalCount      int          `json:"Total_Count,omitempty"`
+	UpdatedCount    int          `json:"Updated_Count,omitempty"`
+	NotUpdatedCount int          `json:"Not_Updated_Count,omitempty"`
+	FailedCount     int          `json:"Failed_Count,omitempty"`
+}
+
+// WaitForMassUpdate blocks until the mass update job jobID of module is completed or has failed. The status is
+// polled at opts.PollInterval, mass updates do not notify a callback so opts.Callback is ignored.
+func (c *API) WaitForMassUpdate(module Module, jobID string, opts BulkWaitOptions) (data MassUpdateJob, err error) {
+	opts.Callback = nil
+	err = waitForBulkJob(jobID, opts, func() (BulkJobState, error) {
+		data, err = c.GetMassUpdateStatus(module, jobID)
+		return data.Status, err
+	})
+	if err != nil {
+		return data, err
+	}
+
+	if data.Status != BulkJobCompleted {
+		return data, fmt.Errorf("Mass update job %s finished with state %s", jobID, data.Status)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,279 @@
+package crm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// FindDuplicates returns the records of module holding the same values as record for every field of fields,
+// record itself is excluded when it holds an id. Fields empty in record are ignored, when every field is
+// empty no search is made. The values are compared by Zoho with the 'equals' operator of SearchRecords.
+func (c *API) FindDuplicates(module Module, record map[string]interface{}, fields []string) ([]map[string]interface{}, error) {
+	var criteria []string
+	for _, f := range fields {
+		value := criteriaValue(record[f])
+		if value == "" {
+			continue
+		}
+		criteria = append(criteria, fmt.Sprintf("(%s:equals:%s)", f, value))
+	}
+	if len(criteria) == 0 {
+		return nil, nil
+	}
+
+	query := strings.Join(criteria, "and")
+	if len(criteria) > 1 {
+		query = "(" + query + ")"
+	}
+
+	id, _ := record["id"].(string)
+	var duplicates []map[string]interface{}
+	for page := 1; ; page++ {
+		v, err := c.SearchRecords(&recordMaps{}, module, map[string]zoho.Parameter{
+			"criteria": zoho.Parameter(query),
+			"page":     zoho.Parameter(fmt.Sprint(page)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Failed to find duplicates of %s: %s", module, err)
+		}
+
+		records := v.(*recordMaps)
+		for _, r := range records.Data {
+			if id != "" && r["id"] == id {
+				continue
+			}
+			duplicates = append(duplicates, r)
+		}
+		if !records.Info.MoreRecords {
+			return duplicates, nil
+		}
+	}
+}
+
+// GroupDuplicates groups records holding the same values for every field of fields, values are compared
+// ignoring case and surrounding spaces. Records with an empty value for one of fields are not grouped.
+// Only groups of 2 records or more are returned, in the order their first record appears in records.
+func GroupDuplicates(records []map[string]interface{}, fields []string) [][]map[string]interface{} {
+	var keys []string
+	groups := map[string][]map[string]interface{}{}
+
+	for _, r := range records {
+		parts := make([]string, 0, len(fields))
+		for _, f := range fields {
+			value := strings.ToLower(strings.TrimSpace(criteriaValue(r[f])))
+			if value == "" {
+				break
+			}
+			parts = append(parts, value)
+		}
+		if len(fields) == 0 || len(parts) != len(fields) {
+			continue
+		}
+
+		key := strings.Join(parts, "\x00")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], r)
+	}
+
+	var duplicates [][]map[string]interface{}
+	for _, k := range keys {
+		if len(groups[k]) > 1 {
+			duplicates = append(duplicates, groups[k])
+		}
+	}
+	return duplicates
+}
+
+// criteriaValue returns value formatted for a search criteria, lookups are compared by id.
+// Parentheses and commas are escaped as required by SearchRecords.
+func criteriaValue(value interface{}) string {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		s = v
+	case map[string]interface{}:
+		id, _ := v["id"].(string)
+		s = id
+	default:
+		s = fmt.Sprint(v)
+	}
+	return strings.NewReplacer(`(`, `\(`, `)`, `\)`, `,`, `\,`).Replace(s)
+}
+
+// MergeOptions is the data provided to MergeRecords
+type MergeOptions struct {
+	// FillEmptyFields copies the values of the duplicates to the fields of the master record which are empty,
+	// the first duplicate holding a value wins
+	FillEmptyFields bool
+	// Fields restricts the fields copied by FillEmptyFields, by default every field which can be written is copied.
+	// Read only fields and the fields computed by Zoho (formula, auto-number, rollup summary) are never copied.
+	Fields []string
+	// CopyNotes and CopyAttachments copy the notes and attachments of the duplicates to the master record
+	CopyNotes       bool
+	CopyAttachments bool
+	// DeleteDuplicates deletes the duplicates once their data was merged in the master record
+	DeleteDuplicates bool
+}
+
+// MergeResult is the data returned by MergeRecords
+type MergeResult struct {
+	MasterID string
+	// UpdatedFields are the API names of the fields of the master record filled from the duplicates
+	UpdatedFields     []string
+	NotesCopied       int
+	AttachmentsCopied int
+	// Deleted are the ids of the duplicates deleted
+	Deleted []string
+}
+
+// MergeRecords merges the records duplicateIDs of module into the record masterID as described by opts.
+// The related records of the duplicates (eg. the Deals of an Account) are not moved.
+// When a step fails the returned result reports what was merged until then.
+func (c *API) MergeRecords(module Module, masterID string, duplicateIDs []string, opts MergeOptions) (data MergeResult, err error) {
+	data.MasterID = masterID
+
+	if opts.FillEmptyFields {
+		writable, err := c.writableFields(module)
+		if err != nil {
+			return data, err
+		}
+
+		master, err := c.getRecordMap(module, masterID)
+		if err != nil {
+			return data, err
+		}
+
+		update := map[string]interface{}{}
+		for _, id := range duplicateIDs {
+			duplicate, err := c.getRecordMap(module, id)
+			if err != nil {
+				return data, err
+			}
+			fields := opts.Fields
+			if len(fields) == 0 {
+				fields = sortedFields(duplicate)
+			}
+			for _, f := range fields {
+				if _, ok := update[f]; ok || !writable[f] || isSystemField(f) || !isEmptyValue(master[f]) || isEmptyValue(duplicate[f]) {
+					continue
+				}
+				update[f] = duplicate[f]
+				data.UpdatedFields = append(data.UpdatedFields, f)
+			}
+		}
+
+		if len(update) > 0 {
+			resp, err := c.UpdateRecord(UpdateRecordData{Data: []map[string]interface{}{update}}, module, masterID)
+			if err != nil {
+				return data, err
+			}
+			for _, r := range resp.Data {
+				if r.Status != "success" {
+					return data, fmt.Errorf("Failed to merge records of %s into %s: %s: %s", module, masterID, r.Code, r.Message)
+				}
+			}
+		}
+	}
+
+	for _, id := range duplicateIDs {
+		if opts.CopyNotes {
+			notes, err := c.recordNotes(module, id)
+			if err != nil {
+				return data, err
+			}
+			n, err := c.copyNotes(notes, module, masterID)
+			data.NotesCopied += n
+			if err != nil {
+				return data, err
+			}
+		}
+
+		if opts.CopyAttachments {
+			files, err := c.readAttachments(module, id)
+			if err != nil {
+				return data, err
+			}
+			n, err := c.writeAttachments(files, module, masterID)
+			data.AttachmentsCopied += n
+			if err != nil {
+				return data, err
+			}
+		}
+	}
+
+	if opts.DeleteDuplicates && len(duplicateIDs) > 0 {
+		// A delete request accepts at most zoho.MaxRecordsPerRequest ids
+		resp, err := c.DeleteRecordsBatched(module, duplicateIDs, BatchOptions{})
+		if err != nil {
+			return data, err
+		}
+		for _, r := range resp.Succeeded() {
+			data.Deleted = append(data.Deleted, duplicateIDs[r.Index])
+		}
+		if err = resp.Err(); err != nil {
+			return data, fmt.Errorf("Failed to delete %d duplicates of %s: %s", len(resp.Failed()), module, err)
+		}
+	}
+
+	return data, nil
+}
+
+// writableFields returns the API names of the fields of module whose value can be set with UpdateRecord,
+// from the fields metadata (see GetFields)
+func (c *API) writableFields(module Module) (map[string]bool, error) {
+	fields, err := c.GetFields(module)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to merge records of %s: %s", module, err)
+	}
+
+	writable := make(map[string]bool, len(fields.Fields))
+	for _, f := range fields.Fields {
+		if !f.IsReadOnly() {
+			writable[f.APIName] = true
+		}
+	}
+	return writable, nil
+}
+
+// isSystemField reports whether the field is maintained by Zoho and cannot be copied between records
+func isSystemField(name string) bool {
+	if strings.HasPrefix(name, "$") {
+		return true
+	}
+	switch name {
+	case "id", "Created_By", "Created_Time", "Modified_By", "Modified_Time", "Last_Activity_Time", "Owner", "Tag":
+		return true
+	}
+	return false
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// sortedFields returns the API names of the fields of record in order
+func sortedFields(record map[string]interface{}) []string {
+	fields := make([]string, 0, len(record))
+	for f := range record {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,259 @@
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// DefaultMetadataTTL is how long the responses of GetFields, GetLayouts, GetCustomViews and
+// GetRelatedLists are cached by default
+const DefaultMetadataTTL = 10 * time.Minute
+
+// metadataCache keeps metadata responses in memory, the zero value uses DefaultMetadataTTL
+type metadataCache struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	disabled bool
+	entries  map[string]metadataEntry
+}
+
+type metadataEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+func (m *metadataCache) get(key string) (interface{}, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.disabled {
+		return nil, false
+	}
+	e, ok := m.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (m *metadataCache) set(key string, value interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.disabled {
+		return
+	}
+	ttl := m.ttl
+	if ttl <= 0 {
+		ttl = DefaultMetadataTTL
+	}
+	if m.entries == nil {
+		m.entries = make(map[string]metadataEntry)
+	}
+	m.entries[key] = metadataEntry{value: value, expires: time.Now().Add(ttl)}
+}
+
+// SetMetadataTTL sets how long metadata responses are cached, zero disables the cache and drops
+// the cached entries. Entries already cached keep their expiry.
+func (c *API) SetMetadataTTL(ttl time.Duration) {
+	c.meta.mu.Lock()
+	c.meta.ttl = ttl
+	c.meta.disabled = ttl <= 0
+	if c.meta.disabled {
+		c.meta.entries = nil
+	}
+	c.meta.mu.Unlock()
+}
+
+// ClearMetadataCache drops every cached metadata response, eg. after a field or layout was changed
+func (c *API) ClearMetadataCache() {
+	c.meta.mu.Lock()
+	c.meta.entries = nil
+	c.meta.mu.Unlock()
+}
+
+// GetLayouts returns the layouts of module with their sections and fields. The response is cached, see SetMetadataTTL.
+// https://www.zoho.com/crm/developer/docs/api/v2/layouts-meta.html
+func (c *API) GetLayouts(module Module) (data LayoutsResponse, err error) {
+	key := "layouts/" + string(module)
+	if v, ok := c.meta.get(key); ok {
+		return v.(LayoutsResponse), nil
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "layouts",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/layouts", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &LayoutsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return LayoutsResponse{}, fmt.Errorf("Failed to retrieve layouts of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*LayoutsResponse); ok {
+		c.meta.set(key, *v)
+		return *v, nil
+	}
+
+	return LayoutsResponse{}, fmt.Errorf("Data retrieved was not 'LayoutsResponse'")
+}
+
+// LayoutsResponse is the data returned by GetLayouts
+type LayoutsResponse struct {
+	Layouts []ModuleLayout `json:"layouts,omitempty"`
+}
+
+// ModuleLayout is the metadata of a layout of a module
+type ModuleLayout struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Visible      bool   `json:"visible,omitempty"`
+	Status       int    `json:"status,omitempty"`
+	CreatedTime  Time   `json:"created_time,omitempty"`
+	ModifiedTime Time   `json:"modified_time,omitempty"`
+	Profiles     []struct {
+		Default bool   `json:"default,omitempty"`
+		Name    string `json:"name,omitempty"`
+		ID      string `json:"id,omitempty"`
+	} `json:"profiles,omitempty"`
+	Sections []LayoutSection `json:"sections,omitempty"`
+}
+
+// Fields returns the fields of every section of the layout
+func (l ModuleLayout) Fields() []Field {
+	var fields []Field
+	for _, s := range l.Sections {
+		fields = append(fields, s.Fields...)
+	}
+	return fields
+}
+
+// LayoutSection is a section of a layout, its fields report whether they are required in the layout
+type LayoutSection struct {
+	Name           string  `json:"name,omitempty"`
+	APIName        string  `json:"api_name,omitempty"`
+	DisplayLabel   string  `json:"display_label,omitempty"`
+	SequenceNumber int     `json:"sequence_number,omitempty"`
+	ColumnCount    int     `json:"column_count,omitempty"`
+	Fields         []Field `json:"fields,omitempty"`
+}
+
+// GetCustomViews returns the custom views of module, the id of a view is the 'cvid' parameter of ListRecords.
+// The response is cached, see SetMetadataTTL.
+// https://www.zoho.com/crm/developer/docs/api/v2/custom-view-meta.html
+func (c *API) GetCustomViews(module Module) (data CustomViewsResponse, err error) {
+	key := "custom_views/" + string(module)
+	if v, ok := c.meta.get(key); ok {
+		return v.(CustomViewsResponse), nil
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "custom views",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/custom_views", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &CustomViewsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CustomViewsResponse{}, fmt.Errorf("Failed to retrieve custom views of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomViewsResponse); ok {
+		c.meta.set(key, *v)
+		return *v, nil
+	}
+
+	return CustomViewsResponse{}, fmt.Errorf("Data retrieved was not 'CustomViewsResponse'")
+}
+
+// CustomViewsResponse is the data returned by GetCustomViews
+type CustomViewsResponse struct {
+	CustomViews []struct {
+		ID            string `json:"id,omitempty"`
+		Name          string `json:"name,omitempty"`
+		DisplayValue  string `json:"display_value,omitempty"`
+		SystemName    string `json:"system_name,omitempty"`
+		Category      string `json:"category,omitempty"`
+		AccessType    string `json:"access_type,omitempty"`
+		Default       bool   `json:"default,omitempty"`
+		SystemDefined bool   `json:"system_defined,omitempty"`
+		Favorite      int    `json:"favorite,omitempty"`
+		Offline       bool   `json:"offline,omitempty"`
+		SortBy        string `json:"sort_by,omitempty"`
+		SortOrder     string `json:"sort_order,omitempty"`
+		// Criteria is the filter of the view, nested groups use the same shape
+		Criteria     json.RawMessage `json:"criteria,omitempty"`
+		CreatedBy    Lookup          `json:"created_by,omitempty"`
+		ModifiedBy   Lookup          `json:"modified_by,omitempty"`
+		CreatedTime  Time            `json:"created_time,omitempty"`
+		ModifiedTime Time            `json:"modified_time,omitempty"`
+	} `json:"custom_views,omitempty"`
+	Info struct {
+		PerPage int    `json:"per_page,omitempty"`
+		Count   int    `json:"count,omitempty"`
+		Page    int    `json:"page,omitempty"`
+		Default string `json:"default,omitempty"`
+		// Translation maps the system names of views to their display names
+		Translation map[string]string `json:"translation,omitempty"`
+	} `json:"info,omitempty"`
+}
+
+// GetRelatedLists returns the related lists of module, their API names are used with ListRelatedRecords.
+// The response is cached, see SetMetadataTTL.
+// https://www.zoho.com/crm/developer/docs/api/v2/related-list-meta.html
+func (c *API) GetRelatedLists(module Module) (data RelatedListsResponse, err error) {
+	key := "related_lists/" + string(module)
+	if v, ok := c.meta.get(key); ok {
+		return v.(RelatedListsResponse), nil
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "related lists",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/related_lists", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &RelatedListsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RelatedListsResponse{}, fmt.Errorf("Failed to retrieve related lists of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RelatedListsResponse); ok {
+		c.meta.set(key, *v)
+		return *v, nil
+	}
+
+	return RelatedListsResponse{}, fmt.Errorf("Data retrieved was not 'RelatedListsResponse'")
+}
+
+// RelatedListsResponse is the data returned by GetRelatedLists
+type RelatedListsResponse struct {
+	RelatedLists []struct {
+		ID           string      `json:"id,omitempty"`
+		APIName      RelatedList `json:"api_name,omitempty"`
+		Name         string      `json:"name,omitempty"`
+		DisplayLabel string      `json:"display_label,omitempty"`
+		// Module is the module of the related records
+		Module         string      `json:"module,omitempty"`
+		Type           string      `json:"type,omitempty"`
+		Href           string      `json:"href,omitempty"`
+		SequenceNumber json.Number `json:"sequence_number,omitempty"`
+	} `json:"related_lists,omitempty"`
+}
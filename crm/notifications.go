@@ -0,0 +1,341 @@
+package crm
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// MaxNotificationExpiry is the longest a notification channel can be enabled for, channels must be
+// renewed before they expire to keep receiving notifications
+const MaxNotificationExpiry = 24 * time.Hour
+
+// NotificationOperation is the operation which triggered a notification
+type NotificationOperation string
+
+const (
+	// NotificationInsert - records were created
+	NotificationInsert NotificationOperation = "insert"
+	// NotificationUpdate - records were modified
+	NotificationUpdate NotificationOperation = "update"
+	// NotificationDelete - records were deleted
+	NotificationDelete NotificationOperation = "delete"
+)
+
+// NotificationChannel subscribes NotifyURL to events of the form "<Module>.<operation>",
+// eg. "Leads.create", "Deals.edit", "Contacts.delete" or "Calls.all"
+type NotificationChannel struct {
+	ChannelID string   `json:"channel_id"`
+	Events    []string `json:"events"`
+	// ChannelExpiry defaults to MaxNotificationExpiry from now
+	ChannelExpiry Time `json:"channel_expiry"`
+	// Token is sent back with every notification so the receiver can verify it, max 50 characters.
+	// It is required by EnableNotifications, UpdateNotifications and NotificationHandler.
+	Token                     string `json:"token,omitempty"`
+	NotifyURL                 string `json:"notify_url"`
+	NotifyOnRelatedAction     bool   `json:"notify_on_related_action,omitempty"`
+	ReturnAffectedFieldValues bool   `json:"return_affected_field_values,omitempty"`
+}
+
+// NotificationsRequest is the data sent to enable or update notification channels
+type NotificationsRequest struct {
+	Watch []NotificationChannel `json:"watch"`
+}
+
+// NotificationsResponse is the data returned when enabling, updating or disabling notification channels
+type NotificationsResponse struct {
+	Watch []struct {
+		Code    string `json:"code,omitempty"`
+		Details struct {
+			Events []struct {
+				ChannelExpiry Time   `json:"channel_expiry,omitempty"`
+				ResourceURI   string `json:"resource_uri,omitempty"`
+				ResourceID    string `json:"resource_id,omitempty"`
+				ResourceName  string `json:"resource_name,omitempty"`
+				ChannelID     string `json:"channel_id,omitempty"`
+			} `json:"events,omitempty"`
+		} `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"watch,omitempty"`
+}
+
+// EnableNotifications subscribes the channels to their events
+// https://www.zoho.com/crm/developer/docs/api/v2/notifications/enable.html
+func (c *API) EnableNotifications(channels ...NotificationChannel) (data NotificationsResponse, err error) {
+	return c.watch(zoho.HTTPPost, "Failed to enable notifications", channels)
+}
+
+// UpdateNotifications replaces the events, expiry, token and URL of existing channels
+// https://www.zoho.com/crm/developer/docs/api/v2/notifications/update-info.html
+func (c *API) UpdateNotifications(channels ...NotificationChannel) (data NotificationsResponse, err error) {
+	return c.watch(zoho.HTTPPut, "Failed to update notifications", channels)
+}
+
+// watch sends the channels to the watch endpoint with the given method
+func (c *API) watch(method zoho.HTTPMethod, failure string, channels []NotificationChannel) (NotificationsResponse, error) {
+	if len(channels) == 0 {
+		return NotificationsResponse{}, fmt.Errorf("%s, must provide at least 1 channel", failure)
+	}
+
+	request := NotificationsRequest{Watch: make([]NotificationChannel, len(channels))}
+	for i, ch := range channels {
+		if ch.Token == "" {
+			return NotificationsResponse{}, fmt.Errorf("%s, channel %s has no token to verify its notifications", failure, ch.ChannelID)
+		}
+		if ch.ChannelExpiry == (Time{}) {
+			// Leave a margin so the expiry is still within the limit when Zoho receives it
+			ch.ChannelExpiry = Time(time.Now().Add(MaxNotificationExpiry - time.Minute))
+		}
+		request.Watch[i] = ch
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "notifications",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/actions/watch", c.ZohoTLD),
+		Method:       method,
+		ResponseData: &NotificationsResponse{},
+		RequestBody:  request,
+	}
+
+	err := c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return NotificationsResponse{}, fmt.Errorf("%s: %s", failure, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*NotificationsResponse); ok {
+		return *v, nil
+	}
+
+	return NotificationsResponse{}, fmt.Errorf("Data returned was not 'NotificationsResponse'")
+}
+
+// GetNotifications returns the enabled notification channels, the 'channel_id' and 'module' parameters filter the list
+// https://www.zoho.com/crm/developer/docs/api/v2/notifications/get-details.html
+func (c *API) GetNotifications(params map[string]zoho.Parameter) (data NotificationDetailsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "notifications",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/actions/watch", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &NotificationDetailsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"channel_id": "",
+			"module":     "",
+			"page":       "",
+			"per_page":   "200",
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return NotificationDetailsResponse{}, fmt.Errorf("Failed to retrieve notifications: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*NotificationDetailsResponse); ok {
+		return *v, nil
+	}
+
+	return NotificationDetailsResponse{}, fmt.Errorf("Data returned was not 'NotificationDetailsResponse'")
+}
+
+// NotificationDetailsResponse is the data returned by GetNotifications, one entry is returned per channel and module
+type NotificationDetailsResponse struct {
+	Watch []struct {
+		NotificationChannel
+		ResourceURI  string `json:"resource_uri,omitempty"`
+		ResourceID   string `json:"resource_id,omitempty"`
+		ResourceName string `json:"resource_name,omitempty"`
+	} `json:"watch,omitempty"`
+	Info PageInfo `json:"info,omitempty"`
+}
+
+// DisableNotifications unsubscribes the channels, they no longer receive any notification
+// https://www.zoho.com/crm/developer/docs/api/v2/notifications/disable.html
+func (c *API) DisableNotifications(channelIDs ...string) (data NotificationsResponse, err error) {
+	if len(channelIDs) == 0 {
+		return NotificationsResponse{}, fmt.Errorf("Failed to disable notifications, must provide at least 1 channel ID")
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "notifications",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/actions/watch", c.ZohoTLD),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &NotificationsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"channel_ids": zoho.Parameter(strings.Join(channelIDs, ",")),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return NotificationsResponse{}, fmt.Errorf("Failed to disable notifications: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*NotificationsResponse); ok {
+		return *v, nil
+	}
+
+	return NotificationsResponse{}, fmt.Errorf("Data returned was not 'NotificationsResponse'")
+}
+
+// RenewNotifications extends the expiry of every enabled channel which expires within before, to
+// MaxNotificationExpiry from now. The renewed channels are returned. It is meant to be called
+// periodically, eg. every hour with before set to two hours.
+func (c *API) RenewNotifications(before time.Duration) (renewed []NotificationChannel, err error) {
+	channels := map[string]*NotificationChannel{}
+	var order []string
+
+	for page := 1; ; page++ {
+		resp, err := c.GetNotifications(map[string]zoho.Parameter{"page": zoho.Parameter(fmt.Sprint(page))})
+		if err != nil {
+			return nil, fmt.Errorf("Failed to renew notifications: %s", err)
+		}
+
+		// Channels are listed once per module, merge their events
+		for _, w := range resp.Watch {
+			if time.Until(time.Time(w.ChannelExpiry)) > before {
+				continue
+			}
+			ch, ok := channels[w.ChannelID]
+			if !ok {
+				ch = &NotificationChannel{
+					ChannelID:                 w.ChannelID,
+					Token:                     w.Token,
+					NotifyURL:                 w.NotifyURL,
+					NotifyOnRelatedAction:     w.NotifyOnRelatedAction,
+					ReturnAffectedFieldValues: w.ReturnAffectedFieldValues,
+				}
+				channels[w.ChannelID] = ch
+				order = append(order, w.ChannelID)
+			}
+			ch.Events = append(ch.Events, w.Events...)
+		}
+
+		if !resp.Info.MoreRecords {
+			break
+		}
+	}
+
+	if len(order) == 0 {
+		return nil, nil
+	}
+
+	for _, id := range order {
+		renewed = append(renewed, *channels[id])
+	}
+	if _, err = c.UpdateNotifications(renewed...); err != nil {
+		return nil, fmt.Errorf("Failed to renew notifications: %s", err)
+	}
+	return renewed, nil
+}
+
+// NotificationEvent is the payload posted by Zoho to the notify URL of a channel
+type NotificationEvent struct {
+	ChannelID   string                `json:"channel_id"`
+	Module      Module                `json:"module"`
+	Operation   NotificationOperation `json:"operation"`
+	IDs         []string              `json:"ids"`
+	ResourceURI string                `json:"resource_uri"`
+	// ServerTime is the time of the event in milliseconds since the epoch
+	ServerTime int64 `json:"server_time"`
+	// AffectedFields lists the modified fields of each record for updates
+	AffectedFields json.RawMessage        `json:"affected_fields,omitempty"`
+	QueryParams    map[string]interface{} `json:"query_params,omitempty"`
+	Token          string                 `json:"token"`
+}
+
+// Time returns ServerTime as a time.Time
+func (e NotificationEvent) Time() time.Time {
+	return time.Unix(0, e.ServerTime*int64(time.Millisecond))
+}
+
+// NotificationHandler is an http.Handler mounted at the notify URL of channels. It rejects notifications
+// whose token does not match the token of their channel and dispatches the others to the registered callbacks.
+type NotificationHandler struct {
+	mu       sync.RWMutex
+	tokens   map[string]string
+	handlers []notificationRoute
+}
+
+type notificationRoute struct {
+	module    Module
+	operation NotificationOperation
+	fn        func(NotificationEvent) error
+}
+
+// NewNotificationHandler returns a *NotificationHandler accepting notifications of the provided channels,
+// every channel must have a token
+func NewNotificationHandler(channels ...NotificationChannel) (*NotificationHandler, error) {
+	h := &NotificationHandler{tokens: make(map[string]string)}
+	for _, ch := range channels {
+		if err := h.AddChannel(ch); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// AddChannel accepts the notifications of channel, which are verified using its token.
+// A channel without a token is rejected, its notifications could not be verified.
+func (h *NotificationHandler) AddChannel(channel NotificationChannel) error {
+	if channel.Token == "" {
+		return fmt.Errorf("Failed to add notification channel %s, a token is required", channel.ChannelID)
+	}
+
+	h.mu.Lock()
+	h.tokens[channel.ChannelID] = channel.Token
+	h.mu.Unlock()
+	return nil
+}
+
+// Handle registers fn for the notifications of module and operation, an empty module or operation matches any.
+// Callbacks run in the order they were registered, an error makes the handler respond with a server error.
+func (h *NotificationHandler) Handle(module Module, operation NotificationOperation, fn func(NotificationEvent) error) {
+	h.mu.Lock()
+	h.handlers = append(h.handlers, notificationRoute{module: module, operation: operation, fn: fn})
+	h.mu.Unlock()
+}
+
+// ServeHTTP verifies and dispatches a notification sent by Zoho
+func (h *NotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	event := NotificationEvent{}
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil || event.ChannelID == "" {
+		http.Error(w, "invalid notification payload", http.StatusBadRequest)
+		return
+	}
+
+	h.mu.RLock()
+	token, ok := h.tokens[event.ChannelID]
+	var routes []notificationRoute
+	for _, route := range h.handlers {
+		if (route.module == "" || strings.EqualFold(string(route.module), string(event.Module))) &&
+			(route.operation == "" || route.operation == event.Operation) {
+			routes = append(routes, route)
+		}
+	}
+	h.mu.RUnlock()
+
+	if !ok || token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(event.Token)) != 1 {
+		http.Error(w, "invalid notification token", http.StatusUnauthorized)
+		return
+	}
+
+	for _, route := range routes {
+		if err := route.fn(event); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
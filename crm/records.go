@@ -487,7 +487,7 @@ func (c *API) ConvertLead(request ConvertLeadData, ID string) (data ConvertLeadR
 
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
-		return ConvertLeadResponse{}, fmt.Errorf("Failed to insert records of %s: %s", LeadsModule, err)
+		return ConvertLeadResponse{}, fmt.Errorf("Failed to convert record of %s: %s", LeadsModule, err)
 	}
 
 	if v, ok := endpoint.ResponseData.(*ConvertLeadResponse); ok {
@@ -499,28 +499,39 @@ func (c *API) ConvertLead(request ConvertLeadData, ID string) (data ConvertLeadR
 
 // ConvertLeadData is the data provided to ConvertLead
 type ConvertLeadData struct {
-	Data []struct {
-		Overwrite            bool   `json:"overwrite,omitempty"`
-		NotifyLeadOwner      bool   `json:"notify_lead_owner,omitempty"`
-		NotifyNewEntityOwner bool   `json:"notify_new_entity_owner,omitempty"`
-		Accounts             string `json:"Accounts,omitempty"`
-		Contacts             string `json:"Contacts,omitempty"`
-		AssignTo             string `json:"assign_to,omitempty"`
-		Deals                struct {
-			CampaignSource string  `json:"Campaign_Source,omitempty"`
-			DealName       string  `json:"Deal_Name,omitempty"`
-			ClosingDate    string  `json:"Closing_Date,omitempty"`
-			Stage          string  `json:"Stage,omitempty"`
-			Amount         float64 `json:"Amount,omitempty"`
-		} `json:"Deals,omitempty"`
-	} `json:"data,omitempty"`
+	Data []ConvertLeadRequest `json:"data,omitempty"`
+}
+
+// ConvertLeadRequest describes how a lead is converted. Accounts and Contacts are the ids of existing
+// records to associate the lead with instead of creating new ones.
+type ConvertLeadRequest struct {
+	Overwrite            bool            `json:"overwrite,omitempty"`
+	NotifyLeadOwner      bool            `json:"notify_lead_owner,omitempty"`
+	NotifyNewEntityOwner bool            `json:"notify_new_entity_owner,omitempty"`
+	Accounts             string          `json:"Accounts,omitempty"`
+	Contacts             string          `json:"Contacts,omitempty"`
+	AssignTo             string          `json:"assign_to,omitempty"`
+	// Deals is the Deal to create, no Deal is created when nil
+	Deals *ConvertLeadDeal `json:"Deals,omitempty"`
+}
+
+// ConvertLeadDeal is the Deal created when converting a lead
+type ConvertLeadDeal struct {
+	CampaignSource string  `json:"Campaign_Source,omitempty"`
+	DealName       string  `json:"Deal_Name,omitempty"`
+	ClosingDate    string  `json:"Closing_Date,omitempty"`
+	Stage          string  `json:"Stage,omitempty"`
+	Amount         float64 `json:"Amount,omitempty"`
 }
 
 // ConvertLeadResponse is the data returned by ConvertLead
 type ConvertLeadResponse struct {
-	Data []struct {
-		Contacts string `json:"Contacts,omitempty"`
-		Deals    string `json:"Deals,omitempty"`
-		Accounts string `json:"Accounts,omitempty"`
-	} `json:"data,omitempty"`
+	Data []ConvertedLead `json:"data,omitempty"`
+}
+
+// ConvertedLead holds the ids of the records a lead was converted to, Deals is empty when no Deal was created
+type ConvertedLead struct {
+	Contacts string `json:"Contacts,omitempty"`
+	Deals    string `json:"Deals,omitempty"`
+	Accounts string `json:"Accounts,omitempty"`
 }
@@ -348,6 +348,66 @@ type Invoice struct {
 }
 
 type Lead struct {
+	Data []struct {
+		Owner struct {
+			Name string `json:"name,omitempty"`
+			ID   string `json:"id,omitempty"`
+		} `json:"Owner,omitempty"`
+		Company        string `json:"Company,omitempty"`
+		Email          string `json:"Email,omitempty"`
+		CurrencySymbol string `json:"$currency_symbol,omitempty"`
+		VisitorScore   string `json:"Visitor_Score,omitempty"`
+		LastActivity   string `json:"Last_Activity_Time,omitempty"`
+		Industry       string `json:"Industry,omitempty"`
+		ProcessFlow    bool   `json:"$process_flow,omitempty"`
+		Street         string `json:"Street,omitempty"`
+		ZipCode        string `json:"Zip_Code,omitempty"`
+		ID             string `json:"id,omitempty"`
+		Approved       bool   `json:"$approved,omitempty"`
+		Approval       struct {
+			Delegate bool `json:"delegate,omitempty"`
+			Approve  bool `json:"approve,omitempty"`
+			Reject   bool `json:"reject,omitempty"`
+			Resubmit bool `json:"resubmit,omitempty"`
+		} `json:"$approval,omitempty"`
+		CreatedTime   string `json:"Created_Time,omitempty"`
+		Editable      bool   `json:"$editable,omitempty"`
+		City          string `json:"City,omitempty"`
+		NoOfEmployees int    `json:"No_of_Employees,omitempty"`
+		State         string `json:"State,omitempty"`
+		Country       string `json:"Country,omitempty"`
+		CreatedBy     struct {
+			Name string `json:"name,omitempty"`
+			ID   string `json:"id,omitempty"`
+		} `json:"Created_By,omitempty"`
+		AnnualRevenue  float64     `json:"Annual_Revenue,omitempty"`
+		SecondaryEmail string      `json:"Secondary_Email,omitempty"`
+		Description    string      `json:"Description,omitempty"`
+		Rating         string      `json:"Rating,omitempty"`
+		Website        string      `json:"Website,omitempty"`
+		Twitter        string      `json:"Twitter,omitempty"`
+		Salutation     string      `json:"Salutation,omitempty"`
+		FirstName      string      `json:"First_Name,omitempty"`
+		LeadStatus     string      `json:"Lead_Status,omitempty"`
+		FullName       string      `json:"Full_Name,omitempty"`
+		RecordImage    interface{} `json:"Record_Image,omitempty"`
+		ModifiedBy     struct {
+			Name string `json:"name,omitempty"`
+			ID   string `json:"id,omitempty"`
+		} `json:"Modified_By,omitempty"`
+		SkypeID      string        `json:"Skype_ID,omitempty"`
+		Phone        string        `json:"Phone,omitempty"`
+		EmailOptOut  bool          `json:"Email_Opt_Out,omitempty"`
+		Designation  string        `json:"Designation,omitempty"`
+		ModifiedTime string        `json:"Modified_Time,omitempty"`
+		Mobile       string        `json:"Mobile,omitempty"`
+		Converted    bool          `json:"$converted,omitempty"`
+		LastName     string        `json:"Last_Name,omitempty"`
+		LeadSource   string        `json:"Lead_Source,omitempty"`
+		Fax          string        `json:"Fax,omitempty"`
+		Tag          []interface{} `json:"Tag,omitempty"`
+	} `json:"data,omitempty"`
+	Info PageInfo `json:"info,omitempty"`
 }
 
 type Potential struct {
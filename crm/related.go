@@ -0,0 +1,193 @@
+package crm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// RelatedList is the API name of a related list of a module, the related lists of a module are
+// returned by the related lists metadata
+type RelatedList string
+
+// Common related lists, custom related lists use the API name shown in the related list metadata
+const (
+	AccountsRelatedList    RelatedList = "Accounts"
+	ActivitiesRelatedList  RelatedList = "Activities"
+	AttachmentsRelatedList RelatedList = "Attachments"
+	CampaignsRelatedList   RelatedList = "Campaigns"
+	CasesRelatedList       RelatedList = "Cases"
+	ContactsRelatedList    RelatedList = "Contacts"
+	DealsRelatedList       RelatedList = "Deals"
+	LeadsRelatedList       RelatedList = "Leads"
+	NotesRelatedList       RelatedList = "Notes"
+	ProductsRelatedList    RelatedList = "Products"
+	QuotesRelatedList      RelatedList = "Quotes"
+)
+
+// ListRelatedRecords will return the records of the related list of the record ID in module. response must be a
+// pointer to a record struct, eg. &crm.Contact{} for the Contacts of an Account, and is populated with the returned page.
+// Paging is controlled with the 'page' and 'per_page' parameters, the Info field of the response reports whether
+// there are more records. A record without related records leaves response empty.
+// https://www.zoho.com/crm/developer/docs/api/v2/get-related-records.html
+func (c *API) ListRelatedRecords(response interface{}, module Module, ID string, relatedList RelatedList, params map[string]zoho.Parameter) (data interface{}, err error) {
+	rv := reflect.ValueOf(response)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return nil, fmt.Errorf("Failed to retrieve %s of %s, response must be a non-nil pointer", relatedList, module)
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "related records",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/%s", c.ZohoTLD, module, ID, relatedList),
+		Method:       zoho.HTTPGet,
+		ResponseData: response,
+		URLParameters: map[string]zoho.Parameter{
+			"fields":   "",
+			"page":     "",
+			"per_page": "200",
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve %s of %s: %s", relatedList, module, err)
+	}
+
+	if endpoint.ResponseData != nil {
+		// HTTPRequest decodes into a new value of the same type, copy it back so response is populated
+		rv.Elem().Set(reflect.ValueOf(endpoint.ResponseData).Elem())
+		return response, nil
+	}
+
+	return nil, fmt.Errorf("Data returned was nil")
+}
+
+// UpdateRelatedRecords links the records in request to the record ID of module, and updates the relation
+// fields they hold (eg. 'Contact_Role' for the Contacts of a Deal). Every record must contain its id.
+// https://www.zoho.com/crm/developer/docs/api/v2/update-related-records.html
+func (c *API) UpdateRelatedRecords(request UpdateRelatedRecordsData, module Module, ID string, relatedList RelatedList) (data RelatedRecordsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "related records",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/%s", c.ZohoTLD, module, ID, relatedList),
+		Method:       zoho.HTTPPut,
+		ResponseData: &RelatedRecordsResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RelatedRecordsResponse{}, fmt.Errorf("Failed to update %s of %s: %s", relatedList, module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RelatedRecordsResponse); ok {
+		return *v, nil
+	}
+
+	return RelatedRecordsResponse{}, fmt.Errorf("Data returned was not 'RelatedRecordsResponse'")
+}
+
+// UpdateRelatedRecord links the record relatedID to the record ID of module. request holds the relation fields
+// to update and may be nil.
+// https://www.zoho.com/crm/developer/docs/api/v2/update-specific-related-record.html
+func (c *API) UpdateRelatedRecord(request interface{}, module Module, ID string, relatedList RelatedList, relatedID string) (data RelatedRecordsResponse, err error) {
+	if request == nil {
+		request = map[string]interface{}{}
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "related records",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/%s/%s", c.ZohoTLD, module, ID, relatedList, relatedID),
+		Method:       zoho.HTTPPut,
+		ResponseData: &RelatedRecordsResponse{},
+		RequestBody: UpdateRelatedRecordsData{
+			Data: []interface{}{request},
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RelatedRecordsResponse{}, fmt.Errorf("Failed to update %s of %s: %s", relatedList, module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RelatedRecordsResponse); ok {
+		return *v, nil
+	}
+
+	return RelatedRecordsResponse{}, fmt.Errorf("Data returned was not 'RelatedRecordsResponse'")
+}
+
+// DelinkRelatedRecords removes the relation between the record ID of module and the related records in ids,
+// the related records themselves are not deleted
+// https://www.zoho.com/crm/developer/docs/api/v2/delink-related-records.html
+func (c *API) DelinkRelatedRecords(module Module, ID string, relatedList RelatedList, ids []string) (data RelatedRecordsResponse, err error) {
+	if len(ids) == 0 {
+		return RelatedRecordsResponse{}, fmt.Errorf("Failed to delink %s, must provide at least 1 ID", relatedList)
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "related records",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/%s", c.ZohoTLD, module, ID, relatedList),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &RelatedRecordsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"ids": zoho.Parameter(strings.Join(ids, ",")),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RelatedRecordsResponse{}, fmt.Errorf("Failed to delink %s of %s: %s", relatedList, module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RelatedRecordsResponse); ok {
+		return *v, nil
+	}
+
+	return RelatedRecordsResponse{}, fmt.Errorf("Data returned was not 'RelatedRecordsResponse'")
+}
+
+// DelinkRelatedRecord removes the relation between the record ID of module and the record relatedID
+// https://www.zoho.com/crm/developer/docs/api/v2/delink-specific-related-record.html
+func (c *API) DelinkRelatedRecord(module Module, ID string, relatedList RelatedList, relatedID string) (data RelatedRecordsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "related records",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/%s/%s/%s/%s", c.ZohoTLD, module, ID, relatedList, relatedID),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &RelatedRecordsResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RelatedRecordsResponse{}, fmt.Errorf("Failed to delink %s of %s: %s", relatedList, module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RelatedRecordsResponse); ok {
+		return *v, nil
+	}
+
+	return RelatedRecordsResponse{}, fmt.Errorf("Data returned was not 'RelatedRecordsResponse'")
+}
+
+// UpdateRelatedRecordsData is the data provided to UpdateRelatedRecords, Data is a slice of records holding
+// the id of the related record and the relation fields to update
+type UpdateRelatedRecordsData struct {
+	Data interface{} `json:"data,omitempty"`
+}
+
+// RelatedRecordsResponse is the data returned when linking, updating or delinking related records
+type RelatedRecordsResponse struct {
+	Data []struct {
+		Code    string `json:"code,omitempty"`
+		Details struct {
+			ID string `json:"id,omitempty"`
+		} `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"data,omitempty"`
+}
@@ -0,0 +1,87 @@
+package crm
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// The tags endpoints are shared with Recruit, see zoho.TagsAPI
+type (
+	Tag                    = zoho.Tag
+	CreateTagsRequest      = zoho.CreateTagsRequest
+	CreateTagsResponse     = zoho.CreateTagsResponse
+	AddTagsResponse        = zoho.AddTagsResponse
+	RemoveTagsResponse     = zoho.AddTagsResponse
+	DeleteTagResponse      = zoho.DeleteTagResponse
+	TagsListResponse       = zoho.TagsListResponse
+	UpdateTagRequest       = zoho.UpdateTagRequest
+	UpdateTagResponse      = zoho.UpdateTagResponse
+	TagRecordCountResponse = zoho.TagRecordCountResponse
+)
+
+// tags returns the shared tags implementation for CRM
+func (c *API) tags() zoho.TagsAPI {
+	return zoho.TagsAPI{Zoho: c.Zoho, BaseURL: fmt.Sprintf("https://www.zohoapis.%s/crm/v2", c.ZohoTLD)}
+}
+
+// CreateTags creates the tags in request for module
+// https://www.zoho.com/crm/developer/docs/api/v2/create-tags.html
+func (c *API) CreateTags(request CreateTagsRequest, module Module) (data CreateTagsResponse, err error) {
+	return c.tags().CreateTags(request, map[string]zoho.Parameter{"module": zoho.Parameter(module)})
+}
+
+// GetTagsList returns the tags of module, the 'my_tags' parameter limits the list to the tags of the current user
+// https://www.zoho.com/crm/developer/docs/api/v2/get-tag-list.html
+func (c *API) GetTagsList(module Module, params map[string]zoho.Parameter) (data TagsListResponse, err error) {
+	return c.tags().GetTags(string(module), params)
+}
+
+// UpdateTag renames the tag ID of module
+// https://www.zoho.com/crm/developer/docs/api/v2/update-tag.html
+func (c *API) UpdateTag(request UpdateTagRequest, module Module, ID string) (data UpdateTagResponse, err error) {
+	return c.tags().UpdateTag(ID, request, map[string]zoho.Parameter{"module": zoho.Parameter(module)})
+}
+
+// DeleteTag deletes the tag ID, it is removed from every record it was added to
+// https://www.zoho.com/crm/developer/docs/api/v2/delete-tag.html
+func (c *API) DeleteTag(ID string) (data DeleteTagResponse, err error) {
+	return c.tags().DeleteTag(ID)
+}
+
+// MergeTags merges the tag conflictID into the tag ID
+// https://www.zoho.com/crm/developer/docs/api/v2/merge-tags.html
+func (c *API) MergeTags(ID string, conflictID string) (data UpdateTagResponse, err error) {
+	return c.tags().MergeTags(ID, conflictID)
+}
+
+// GetTagRecordCount returns the number of records of module tagged with the tag ID
+// https://www.zoho.com/crm/developer/docs/api/v2/get-record-count-tag.html
+func (c *API) GetTagRecordCount(module Module, ID string) (data TagRecordCountResponse, err error) {
+	return c.tags().GetTagRecordCount(string(module), ID)
+}
+
+// AddTagsToIDs adds tags to records of module, the 'tag_names' and 'ids' parameters are comma separated lists
+// and 'over_write' replaces the existing tags of the records
+// https://www.zoho.com/crm/developer/docs/api/v2/add-tags-to-multiple-records.html
+func (c *API) AddTagsToIDs(module Module, params map[string]zoho.Parameter) (data AddTagsResponse, err error) {
+	return c.tags().AddTagsToIDs(string(module), params)
+}
+
+// AddTagsToID adds tags to the record ID of module, the 'tag_names' parameter is a comma separated list
+// https://www.zoho.com/crm/developer/docs/api/v2/add-tags.html
+func (c *API) AddTagsToID(module Module, ID string, params map[string]zoho.Parameter) (data AddTagsResponse, err error) {
+	return c.tags().AddTagsToID(string(module), ID, params)
+}
+
+// RemoveTagsFromIDs removes tags from records of module, the 'tag_names' and 'ids' parameters are comma separated lists
+// https://www.zoho.com/crm/developer/docs/api/v2/remove-tags-from-multiple-records.html
+func (c *API) RemoveTagsFromIDs(module Module, params map[string]zoho.Parameter) (data RemoveTagsResponse, err error) {
+	return c.tags().RemoveTagsFromIDs(string(module), params)
+}
+
+// RemoveTagsFromID removes tags from the record ID of module, the 'tag_names' parameter is a comma separated list
+// https://www.zoho.com/crm/developer/docs/api/v2/remove-tags.html
+func (c *API) RemoveTagsFromID(module Module, ID string, params map[string]zoho.Parameter) (data RemoveTagsResponse, err error) {
+	return c.tags().RemoveTagsFromID(string(module), ID, params)
+}
@@ -0,0 +1,183 @@
+package crm
+
+import (
+	"fmt"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// GetTerritories will return the list of territories in this CRM organization
+// https://www.zoho.com/crm/developer/docs/api/v2/get-territories.html
+func (c *API) GetTerritories() (data TerritoriesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "territories",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/territories", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TerritoriesResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TerritoriesResponse{}, fmt.Errorf("Failed to retrieve territories: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TerritoriesResponse); ok {
+		return *v, nil
+	}
+
+	return TerritoriesResponse{}, fmt.Errorf("Data retrieved was not 'TerritoriesResponse'")
+}
+
+// GetTerritory will return the territory specified by id
+// https://www.zoho.com/crm/developer/docs/api/v2/get-territories.html
+func (c *API) GetTerritory(id string) (data TerritoriesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "territories",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/territories/%s", c.ZohoTLD, id),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TerritoriesResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TerritoriesResponse{}, fmt.Errorf("Failed to retrieve territory (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TerritoriesResponse); ok {
+		return *v, nil
+	}
+
+	return TerritoriesResponse{}, fmt.Errorf("Data retrieved was not 'TerritoriesResponse'")
+}
+
+// TerritoriesResponse is the data returned by GetTerritories and GetTerritory
+type TerritoriesResponse struct {
+	Territories []struct {
+		ID             string `json:"id,omitempty"`
+		Name           string `json:"name,omitempty"`
+		Description    string `json:"description,omitempty"`
+		ParentID       string `json:"parent_id,omitempty"`
+		Manager        Lookup `json:"manager,omitempty"`
+		CreatedBy      Lookup `json:"created_by,omitempty"`
+		CreatedTime    Time   `json:"created_time,omitempty"`
+		ModifiedBy     Lookup `json:"modified_by,omitempty"`
+		ModifiedTime   Time   `json:"modified_time,omitempty"`
+		PermissionType string `json:"permission_type,omitempty"`
+	} `json:"territories,omitempty"`
+}
+
+// GetUserTerritories will return the territories the user specified by id belongs to
+// https://www.zoho.com/crm/developer/docs/api/v2.1/get-territories-of-user.html
+func (c *API) GetUserTerritories(id string) (data UserTerritoriesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "territories",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2.1/users/%s/territories", c.ZohoTLD, id),
+		Method:       zoho.HTTPGet,
+		ResponseData: &UserTerritoriesResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return UserTerritoriesResponse{}, fmt.Errorf("Failed to retrieve territories of user (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*UserTerritoriesResponse); ok {
+		return *v, nil
+	}
+
+	return UserTerritoriesResponse{}, fmt.Errorf("Data retrieved was not 'UserTerritoriesResponse'")
+}
+
+// UserTerritoriesResponse is the data returned by GetUserTerritories
+type UserTerritoriesResponse struct {
+	Territories []struct {
+		ID          string `json:"id,omitempty"`
+		Name        string `json:"name,omitempty"`
+		Manager     Lookup `json:"manager,omitempty"`
+		ReportingTo Lookup `json:"reporting_to,omitempty"`
+	} `json:"territories,omitempty"`
+}
+
+// AssignTerritories will add the user specified by id to the territories territoryIDs
+// https://www.zoho.com/crm/developer/docs/api/v2.1/associate-territories-to-user.html
+func (c *API) AssignTerritories(id string, territoryIDs ...string) (data UserTerritoriesActionResponse, err error) {
+	if len(territoryIDs) == 0 {
+		return UserTerritoriesActionResponse{}, fmt.Errorf("Failed to assign territories, must provide at least 1 ID")
+	}
+
+	request := UserTerritoriesData{}
+	for _, t := range territoryIDs {
+		request.Territories = append(request.Territories, struct {
+			ID string `json:"id"`
+		}{ID: t})
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "territories",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2.1/users/%s/territories", c.ZohoTLD, id),
+		Method:       zoho.HTTPPut,
+		ResponseData: &UserTerritoriesActionResponse{},
+		RequestBody:  request,
+	}
+
+	return c.sendTerritoriesAction(&endpoint, fmt.Sprintf("Failed to assign territories to user (%s)", id))
+}
+
+// RemoveTerritories will remove the user specified by id from the territories territoryIDs
+// https://www.zoho.com/crm/developer/docs/api/v2.1/remove-territories-from-user.html
+func (c *API) RemoveTerritories(id string, territoryIDs ...string) (data UserTerritoriesActionResponse, err error) {
+	if len(territoryIDs) == 0 {
+		return UserTerritoriesActionResponse{}, fmt.Errorf("Failed to remove territories, must provide at least 1 ID")
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "territories",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2.1/users/%s/territories", c.ZohoTLD, id),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &UserTerritoriesActionResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"ids": zoho.Parameter(strings.Join(territoryIDs, ",")),
+		},
+	}
+
+	return c.sendTerritoriesAction(&endpoint, fmt.Sprintf("Failed to remove territories from user (%s)", id))
+}
+
+// sendTerritoriesAction performs a request changing the territories of a user and checks the status of every territory
+func (c *API) sendTerritoriesAction(endpoint *zoho.Endpoint, failure string) (UserTerritoriesActionResponse, error) {
+	err := c.Zoho.HTTPRequest(endpoint)
+	if err != nil {
+		return UserTerritoriesActionResponse{}, fmt.Errorf("%s: %s", failure, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*UserTerritoriesActionResponse); ok {
+		for _, t := range v.Territories {
+			if t.Status != "success" {
+				return *v, fmt.Errorf("%s: %s: %s", failure, t.Code, t.Message)
+			}
+		}
+		return *v, nil
+	}
+
+	return UserTerritoriesActionResponse{}, fmt.Errorf("Data returned was not 'UserTerritoriesActionResponse'")
+}
+
+// UserTerritoriesData is the data provided to AssignTerritories
+type UserTerritoriesData struct {
+	Territories []struct {
+		ID string `json:"id"`
+	} `json:"territories"`
+}
+
+// UserTerritoriesActionResponse is the data returned by AssignTerritories and RemoveTerritories
+type UserTerritoriesActionResponse struct {
+	Territories []struct {
+		Code    string `json:"code,omitempty"`
+		Details struct {
+			ID string `json:"id,omitempty"`
+		} `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"territories,omitempty"`
+}
@@ -1,7 +1,6 @@
 package crm
 
 import (
-	"encoding/json"
 	zoho "github.com/iapon/zoho"
 )
 
@@ -45,136 +44,16 @@ type Layout struct {
 type AutoNumber string
 
 // SingleLine is the field type in Zoho that defines a single line input field
-type SingleLine string
-
-func (s *SingleLine) UnmarshalJSON(data []byte) error {
-	if string(data) == "null" {
-		t := SingleLine("")
-		s = &t
-		return nil
-	}
-
-	var t string
-	if err := json.Unmarshal(data, &t); err != nil {
-		return err
-	}
-
-	tp := SingleLine(t)
-	s = &tp
-	return nil
-}
-
-func (s SingleLine) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
-}
+type SingleLine = zoho.SingleLine
 
 // MultiLine is the field type in Zoho that defines a multiline input field, like text area in HTML
-type MultiLine string
-
-func (s *MultiLine) UnmarshalJSON(data []byte) error {
-	if string(data) == "null" {
-		t := MultiLine("")
-		s = &t
-		return nil
-	}
-
-	var t string
-	if err := json.Unmarshal(data, &t); err != nil {
-		return err
-	}
-
-	tp := MultiLine(t)
-	s = &tp
-	return nil
-}
-
-func (s MultiLine) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
-}
+type MultiLine = zoho.MultiLine
 
 // Email is the field type in Zoho that defines an email address field
-type Email string
-
-func (s *Email) UnmarshalJSON(data []byte) error {
-	if string(data) == "null" {
-		t := Email("")
-		s = &t
-		return nil
-	}
-
-	var t string
-	if err := json.Unmarshal(data, &t); err != nil {
-		return err
-	}
-
-	tp := Email(t)
-	s = &tp
-	return nil
-}
-
-func (s Email) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
-}
+type Email = zoho.Email
 
 // Phone is the field type in Zoho that defines a phone number field
-type Phone string
-
-func (s *Phone) UnmarshalJSON(data []byte) error {
-	if string(data) == "null" {
-		t := Phone("")
-		s = &t
-		return nil
-	}
-
-	var t string
-	if err := json.Unmarshal(data, &t); err != nil {
-		return err
-	}
-
-	tp := Phone(t)
-	s = &tp
-	return nil
-}
-
-func (s Phone) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
-}
+type Phone = zoho.Phone
 
 // PickList is the field type in Zoho that defines a dropdown that has been selected
-type PickList string
-
-func (s *PickList) UnmarshalJSON(data []byte) error {
-	if string(data) == "null" {
-		t := PickList("")
-		s = &t
-		return nil
-	}
-
-	var t string
-	if err := json.Unmarshal(data, &t); err != nil {
-		return err
-	}
-
-	tp := PickList(t)
-	s = &tp
-	return nil
-}
-
-func (s PickList) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
-}
+type PickList = zoho.PickList
@@ -53,6 +53,297 @@ func (c *API) GetUser(id string) (data UsersResponse, err error) {
 	return UsersResponse{}, fmt.Errorf("Data retrieved was not 'UsersResponse'")
 }
 
+// ListUsers will return a page of the users in the CRM organization filtered by kind. Paging is controlled
+// with the 'page' and 'per_page' parameters, the Info field of the response reports whether there are more users.
+// https://www.zoho.com/crm/developer/docs/api/v2/get-users.html
+func (c *API) ListUsers(kind UserType, params map[string]zoho.Parameter) (data UsersResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "users",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/users", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &UsersResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"type":     kind,
+			"page":     "",
+			"per_page": "200",
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return UsersResponse{}, fmt.Errorf("Failed to retrieve users: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*UsersResponse); ok {
+		return *v, nil
+	}
+
+	return UsersResponse{}, fmt.Errorf("Data retrieved was not 'UsersResponse'")
+}
+
+// GetAllUsers will return every user of the CRM organization filtered by kind, requesting every page of ListUsers
+func (c *API) GetAllUsers(kind UserType) (data []User, err error) {
+	for page := 1; ; page++ {
+		resp, err := c.ListUsers(kind, map[string]zoho.Parameter{
+			"page": zoho.Parameter(fmt.Sprint(page)),
+		})
+		if err != nil {
+			return data, err
+		}
+		data = append(data, resp.Users...)
+		if !resp.Info.MoreRecords {
+			return data, nil
+		}
+	}
+}
+
+// AddUser will add the users in request to the CRM organization, every user requires a last name, email, role and profile.
+// An invitation is sent to the email of each user.
+// https://www.zoho.com/crm/developer/docs/api/v2/add-user.html
+func (c *API) AddUser(request UserData) (data UserActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "users",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/users", c.ZohoTLD),
+		Method:       zoho.HTTPPost,
+		ResponseData: &UserActionResponse{},
+		RequestBody:  request,
+	}
+
+	return c.sendUserAction(&endpoint, "Failed to add users")
+}
+
+// UpdateUsers will update the users in request, every user must contain its id
+// https://www.zoho.com/crm/developer/docs/api/v2/update-user.html
+func (c *API) UpdateUsers(request UserData) (data UserActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "users",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/users", c.ZohoTLD),
+		Method:       zoho.HTTPPut,
+		ResponseData: &UserActionResponse{},
+		RequestBody:  request,
+	}
+
+	return c.sendUserAction(&endpoint, "Failed to update users")
+}
+
+// UpdateUser will update the user specified by id with the first user in request
+// https://www.zoho.com/crm/developer/docs/api/v2/update-user.html
+func (c *API) UpdateUser(request UserData, id string) (data UserActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "users",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/users/%s", c.ZohoTLD, id),
+		Method:       zoho.HTTPPut,
+		ResponseData: &UserActionResponse{},
+		RequestBody:  request,
+	}
+
+	return c.sendUserAction(&endpoint, fmt.Sprintf("Failed to update user (%s)", id))
+}
+
+// ActivateUser will set the status of the user specified by id to active
+func (c *API) ActivateUser(id string) (data UserActionResponse, err error) {
+	return c.UpdateUser(UserData{Users: []UserFields{{Status: UserStatusActive}}}, id)
+}
+
+// DeactivateUser will set the status of the user specified by id to disabled, the user can no longer sign in
+// but keeps their records
+func (c *API) DeactivateUser(id string) (data UserActionResponse, err error) {
+	return c.UpdateUser(UserData{Users: []UserFields{{Status: UserStatusDisabled}}}, id)
+}
+
+// AssignRole will set the role of the user specified by id to the role roleID
+func (c *API) AssignRole(id string, roleID string) (data UserActionResponse, err error) {
+	return c.UpdateUser(UserData{Users: []UserFields{{Role: roleID}}}, id)
+}
+
+// AssignProfile will set the profile of the user specified by id to the profile profileID
+func (c *API) AssignProfile(id string, profileID string) (data UserActionResponse, err error) {
+	return c.UpdateUser(UserData{Users: []UserFields{{Profile: profileID}}}, id)
+}
+
+// DeleteUser will delete the user specified by id, use TransferAndDeleteUser to keep the records of the user
+// https://www.zoho.com/crm/developer/docs/api/v2/delete-user.html
+func (c *API) DeleteUser(id string) (data UserActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "users",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/users/%s", c.ZohoTLD, id),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &UserActionResponse{},
+	}
+
+	return c.sendUserAction(&endpoint, fmt.Sprintf("Failed to delete user (%s)", id))
+}
+
+// sendUserAction performs a request adding, updating or deleting users and checks the status of every user
+func (c *API) sendUserAction(endpoint *zoho.Endpoint, failure string) (UserActionResponse, error) {
+	err := c.Zoho.HTTPRequest(endpoint)
+	if err != nil {
+		return UserActionResponse{}, fmt.Errorf("%s: %s", failure, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*UserActionResponse); ok {
+		for _, u := range v.Users {
+			if u.Status != "success" {
+				return *v, fmt.Errorf("%s: %s: %s", failure, u.Code, u.Message)
+			}
+		}
+		return *v, nil
+	}
+
+	return UserActionResponse{}, fmt.Errorf("Data returned was not 'UserActionResponse'")
+}
+
+// UserStatus is the status of a user
+type UserStatus string
+
+const (
+	// UserStatusActive - the user can sign in
+	UserStatusActive UserStatus = "active"
+	// UserStatusDisabled - the user was deactivated
+	UserStatusDisabled UserStatus = "disabled"
+)
+
+// UserData is the data provided to AddUser, UpdateUsers and UpdateUser
+type UserData struct {
+	Users []UserFields `json:"users"`
+}
+
+// UserFields are the fields of a user which can be set, Role and Profile are ids
+type UserFields struct {
+	ID          string     `json:"id,omitempty"`
+	FirstName   string     `json:"first_name,omitempty"`
+	LastName    string     `json:"last_name,omitempty"`
+	Email       string     `json:"email,omitempty"`
+	Role        string     `json:"role,omitempty"`
+	Profile     string     `json:"profile,omitempty"`
+	Status      UserStatus `json:"status,omitempty"`
+	Phone       string     `json:"phone,omitempty"`
+	Mobile      string     `json:"mobile,omitempty"`
+	Fax         string     `json:"fax,omitempty"`
+	Website     string     `json:"website,omitempty"`
+	Dob         string     `json:"dob,omitempty"`
+	Street      string     `json:"street,omitempty"`
+	City        string     `json:"city,omitempty"`
+	State       string     `json:"state,omitempty"`
+	Zip         string     `json:"zip,omitempty"`
+	Country     string     `json:"country,omitempty"`
+	Language    string     `json:"language,omitempty"`
+	Locale      string     `json:"locale,omitempty"`
+	TimeZone    string     `json:"time_zone,omitempty"`
+	TimeFormat  string     `json:"time_format,omitempty"`
+	DateFormat  string     `json:"date_format,omitempty"`
+	Alias       string     `json:"alias,omitempty"`
+	ReportingTo string     `json:"Reporting_To,omitempty"`
+}
+
+// UserActionResponse is the data returned when adding, updating or deleting users
+type UserActionResponse struct {
+	Users []struct {
+		Code    string `json:"code,omitempty"`
+		Details struct {
+			ID string `json:"id,omitempty"`
+		} `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"users,omitempty"`
+}
+
+// TransferAndDeleteUser transfers the records, assignment rules and criteria of the user specified by id
+// to the user request.Transfer.ID, moves their subordinates to request.MoveSubordinate and deletes the user.
+// The transfer runs in the background, the returned job id is used with GetTransferAndDeleteStatus.
+// https://www.zoho.com/crm/developer/docs/api/v2.1/transfer-and-delete-user.html
+func (c *API) TransferAndDeleteUser(request TransferAndDeleteData, id string) (data TransferAndDeleteResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "users",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2.1/users/%s/actions/transfer_and_delete", c.ZohoTLD, id),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TransferAndDeleteResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TransferAndDeleteResponse{}, fmt.Errorf("Failed to transfer and delete user (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TransferAndDeleteResponse); ok {
+		return *v, nil
+	}
+
+	return TransferAndDeleteResponse{}, fmt.Errorf("Data returned was not 'TransferAndDeleteResponse'")
+}
+
+// TransferAndDeleteData is the data provided to TransferAndDeleteUser
+type TransferAndDeleteData struct {
+	TransferAndDelete []TransferAndDelete `json:"transfer_and_delete"`
+}
+
+// TransferAndDelete describes what is transferred from a deleted user
+type TransferAndDelete struct {
+	Transfer struct {
+		// ID is the user receiving the records
+		ID         string `json:"id"`
+		Records    bool   `json:"records"`
+		Assignment bool   `json:"assignment"`
+		Criteria   bool   `json:"criteria"`
+	} `json:"transfer"`
+	// MoveSubordinate is the user the subordinates of the deleted user report to, it is required when the user has subordinates
+	MoveSubordinate *struct {
+		ID string `json:"id"`
+	} `json:"move_subordinate,omitempty"`
+}
+
+// TransferAndDeleteResponse is the data returned by TransferAndDeleteUser
+type TransferAndDeleteResponse struct {
+	TransferAndDelete []struct {
+		Code    string `json:"code,omitempty"`
+		Details struct {
+			JobID string `json:"job_id,omitempty"`
+		} `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"transfer_and_delete,omitempty"`
+}
+
+// GetTransferAndDeleteStatus returns the status of the transfer and delete job jobID, eg. "in_progress" or "completed"
+// https://www.zoho.com/crm/developer/docs/api/v2.1/transfer-and-delete-user.html
+func (c *API) GetTransferAndDeleteStatus(jobID string) (status string, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "users",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2.1/users/actions/transfer_and_delete", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TransferAndDeleteStatusResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"job_id": zoho.Parameter(jobID),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return "", fmt.Errorf("Failed to retrieve transfer and delete job %s: %s", jobID, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TransferAndDeleteStatusResponse); ok {
+		if len(v.TransferAndDelete) == 0 {
+			return "", fmt.Errorf("Failed to retrieve transfer and delete job %s: no job returned", jobID)
+		}
+		return v.TransferAndDelete[0].Status, nil
+	}
+
+	return "", fmt.Errorf("Data returned was not 'TransferAndDeleteStatusResponse'")
+}
+
+// TransferAndDeleteStatusResponse is the data returned by GetTransferAndDeleteStatus
+type TransferAndDeleteStatusResponse struct {
+	TransferAndDelete []struct {
+		Status string `json:"status,omitempty"`
+	} `json:"transfer_and_delete,omitempty"`
+}
+
 // UserType is the 'kind' parameter in the GetUsers function
 type UserType = zoho.Parameter
 
@@ -81,91 +372,94 @@ const (
 	CurrentUser UserType = "CurrentUser"
 )
 
-// UsersResponse is the data returned by GetUsers and GetUser
+// UsersResponse is the data returned by GetUsers, GetUser and ListUsers
 type UsersResponse struct {
-	Users []struct {
-		Country string `json:"country,omitempty"`
-		Role    struct {
-			Name string `json:"name,omitempty"`
-			ID   string `json:"id,omitempty"`
-		} `json:"role,omitempty"`
-		City       string `json:"city,omitempty"`
-		Language   string `json:"language,omitempty"`
-		Locale     string `json:"locale,omitempty"`
-		ModifiedBy struct {
-			Name string `json:"name,omitempty"`
-			ID   string `json:"id,omitempty"`
-		} `json:"Modified_By,omitempty"`
-		Street        string `json:"street,omitempty"`
-		Currency      string `json:"Currency,omitempty"`
-		Alias         string `json:"alias,omitempty"`
-		ID            string `json:"id,omitempty"`
-		State         string `json:"state,omitempty"`
-		Fax           string `json:"fax,omitempty"`
-		CountryLocale string `json:"country_locale,omitempty"`
-		FirstName     string `json:"first_name,omitempty"`
-		Email         string `json:"email,omitempty"`
-		ReportingTo   string `json:"Reporting_To,omitempty"`
-		Zip           string `json:"zip,omitempty"`
-		CreatedTime   string `json:"created_time,omitempty"`
-		ModifiedTime  string `json:"modified_time,omitempty"`
-		Website       string `json:"website,omitempty"`
-		TimeFormat    string `json:"time_format,omitempty"`
-		Offset        int64  `json:"offset,omitempty"`
-		Profile       struct {
-			Name string `json:"name,omitempty"`
-			ID   string `json:"id,omitempty"`
-		} `json:"profile,omitempty"`
-		Mobile    string `json:"mobile,omitempty"`
-		LastName  string `json:"last_name,omitempty"`
-		CreatedBy struct {
-			Name string `json:"name,omitempty"`
-			ID   string `json:"id,omitempty"`
-		} `json:"created_by,omitempty"`
-		Zuid        string `json:"zuid,omitempty"`
-		Confirm     bool   `json:"confirm,omitempty"`
-		FullName    string `json:"full_name,omitempty"`
-		Territories []struct {
-			Manager bool   `json:"manager,omitempty"`
-			Name    string `json:"name,omitempty"`
-			ID      string `json:"id,omitempty"`
-		} `json:"territories,omitempty"`
-		Phone         string `json:"phone,omitempty"`
-		Dob           string `json:"dob,omitempty"`
-		DateFormat    string `json:"date_format,omitempty"`
-		Status        string `json:"status,omitempty"`
-		CustomizeInfo struct {
-			NotesDesc       string `json:"notes_desc,omitempty"`
-			ShowRightPanel  bool   `json:"show_right_panel,omitempty"`
-			BcView          string `json:"bc_view,omitempty"`
-			ShowHome        bool   `json:"show_home,omitempty"`
-			UnpinRecentItem bool   `json:"unpin_recent_item,omitempty"`
-		} `json:"customize_info,omitempty,omitempty"`
-		Signature           string `json:"signature,omitempty,omitempty"`
-		NameFormat          string `json:"name_format,omitempty,omitempty"`
-		PersonalAccount     bool   `json:"personal_account,omitempty,omitempty"`
-		NtcNotificationType []int  `json:"ntc_notification_type,omitempty,omitempty"`
-		DefaultTabGroup     string `json:"default_tab_group,omitempty,omitempty"`
-		Theme               struct {
-			NormalTab struct {
-				FontColor  string `json:"font_color,omitempty"`
-				Background string `json:"background,omitempty"`
-			} `json:"normal_tab,omitempty"`
-			SelectedTab struct {
-				FontColor  string `json:"font_color,omitempty"`
-				Background string `json:"background,omitempty"`
-			} `json:"selected_tab,omitempty"`
-			NewBackground string `json:"new_background,omitempty"`
-			Background    string `json:"background,omitempty"`
-			Screen        string `json:"screen,omitempty"`
-			Type          string `json:"type,omitempty"`
-		} `json:"theme,omitempty,omitempty"`
-		TelephonyEnabled bool   `json:"telephony_enabled,omitempty,omitempty"`
-		ImapStatus       bool   `json:"imap_status,omitempty,omitempty"`
-		DecimalSeparator string `json:"decimal_separator,omitempty,omitempty"`
-		TimeZone         string `json:"time_zone,omitempty"`
-		RtlEnabled       bool   `json:"rtl_enabled,omitempty,omitempty"`
-		NtcEnabled       bool   `json:"ntc_enabled,omitempty,omitempty"`
-	} `json:"users,omitempty"`
-	Info PageInfo `json:"info,omitempty"`
+	Users []User   `json:"users,omitempty"`
+	Info  PageInfo `json:"info,omitempty"`
+}
+
+// User is a user of the CRM organization
+type User struct {
+	Country string `json:"country,omitempty"`
+	Role    struct {
+		Name string `json:"name,omitempty"`
+		ID   string `json:"id,omitempty"`
+	} `json:"role,omitempty"`
+	City       string `json:"city,omitempty"`
+	Language   string `json:"language,omitempty"`
+	Locale     string `json:"locale,omitempty"`
+	ModifiedBy struct {
+		Name string `json:"name,omitempty"`
+		ID   string `json:"id,omitempty"`
+	} `json:"Modified_By,omitempty"`
+	Street        string `json:"street,omitempty"`
+	Currency      string `json:"Currency,omitempty"`
+	Alias         string `json:"alias,omitempty"`
+	ID            string `json:"id,omitempty"`
+	State         string `json:"state,omitempty"`
+	Fax           string `json:"fax,omitempty"`
+	CountryLocale string `json:"country_locale,omitempty"`
+	FirstName     string `json:"first_name,omitempty"`
+	Email         string `json:"email,omitempty"`
+	ReportingTo   string `json:"Reporting_To,omitempty"`
+	Zip           string `json:"zip,omitempty"`
+	CreatedTime   string `json:"created_time,omitempty"`
+	ModifiedTime  string `json:"modified_time,omitempty"`
+	Website       string `json:"website,omitempty"`
+	TimeFormat    string `json:"time_format,omitempty"`
+	Offset        int64  `json:"offset,omitempty"`
+	Profile       struct {
+		Name string `json:"name,omitempty"`
+		ID   string `json:"id,omitempty"`
+	} `json:"profile,omitempty"`
+	Mobile    string `json:"mobile,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	CreatedBy struct {
+		Name string `json:"name,omitempty"`
+		ID   string `json:"id,omitempty"`
+	} `json:"created_by,omitempty"`
+	Zuid        string `json:"zuid,omitempty"`
+	Confirm     bool   `json:"confirm,omitempty"`
+	FullName    string `json:"full_name,omitempty"`
+	Territories []struct {
+		Manager bool   `json:"manager,omitempty"`
+		Name    string `json:"name,omitempty"`
+		ID      string `json:"id,omitempty"`
+	} `json:"territories,omitempty"`
+	Phone         string `json:"phone,omitempty"`
+	Dob           string `json:"dob,omitempty"`
+	DateFormat    string `json:"date_format,omitempty"`
+	Status        string `json:"status,omitempty"`
+	CustomizeInfo struct {
+		NotesDesc       string `json:"notes_desc,omitempty"`
+		ShowRightPanel  bool   `json:"show_right_panel,omitempty"`
+		BcView          string `json:"bc_view,omitempty"`
+		ShowHome        bool   `json:"show_home,omitempty"`
+		UnpinRecentItem bool   `json:"unpin_recent_item,omitempty"`
+	} `json:"customize_info,omitempty,omitempty"`
+	Signature           string `json:"signature,omitempty,omitempty"`
+	NameFormat          string `json:"name_format,omitempty,omitempty"`
+	PersonalAccount     bool   `json:"personal_account,omitempty,omitempty"`
+	NtcNotificationType []int  `json:"ntc_notification_type,omitempty,omitempty"`
+	DefaultTabGroup     string `json:"default_tab_group,omitempty,omitempty"`
+	Theme               struct {
+		NormalTab struct {
+			FontColor  string `json:"font_color,omitempty"`
+			Background string `json:"background,omitempty"`
+		} `json:"normal_tab,omitempty"`
+		SelectedTab struct {
+			FontColor  string `json:"font_color,omitempty"`
+			Background string `json:"background,omitempty"`
+		} `json:"selected_tab,omitempty"`
+		NewBackground string `json:"new_background,omitempty"`
+		Background    string `json:"background,omitempty"`
+		Screen        string `json:"screen,omitempty"`
+		Type          string `json:"type,omitempty"`
+	} `json:"theme,omitempty,omitempty"`
+	TelephonyEnabled bool   `json:"telephony_enabled,omitempty,omitempty"`
+	ImapStatus       bool   `json:"imap_status,omitempty,omitempty"`
+	DecimalSeparator string `json:"decimal_separator,omitempty,omitempty"`
+	TimeZone         string `json:"time_zone,omitempty"`
+	RtlEnabled       bool   `json:"rtl_enabled,omitempty,omitempty"`
+	NtcEnabled       bool   `json:"ntc_enabled,omitempty,omitempty"`
 }
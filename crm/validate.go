@@ -0,0 +1,68 @@
+package crm
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// Validator checks records against the field metadata of a module, see zoho.Validator
+type Validator = zoho.Validator
+
+// ValidationErrors are the field errors returned by ValidateRecords
+type ValidationErrors = zoho.ValidationErrors
+
+// NewValidator returns a Validator for module built from its fields metadata and the required fields of the
+// layout layoutID, the first layout is used when layoutID is empty. The metadata is cached, see SetMetadataTTL.
+func (c *API) NewValidator(module Module, layoutID string) (*Validator, error) {
+	fields, err := c.GetFields(module)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create validator for %s: %s", module, err)
+	}
+	layouts, err := c.GetLayouts(module)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create validator for %s: %s", module, err)
+	}
+
+	required := map[string]bool{}
+	for i, l := range layouts.Layouts {
+		if (layoutID == "" && i == 0) || l.ID == layoutID {
+			for _, f := range l.Fields() {
+				if f.Required {
+					required[f.APIName] = true
+				}
+			}
+		}
+	}
+
+	rules := make([]zoho.FieldRule, 0, len(fields.Fields))
+	for _, f := range fields.Fields {
+		rule := zoho.FieldRule{
+			APIName:  f.APIName,
+			DataType: f.DataType,
+			Length:   f.Length,
+			Required: f.SystemMandatory || required[f.APIName],
+			ReadOnly: f.IsReadOnly(),
+		}
+		for _, p := range f.PickListValues {
+			value := p.ActualValue
+			if value == "" {
+				value = p.DisplayValue
+			}
+			rule.PickListValues = append(rule.PickListValues, value)
+		}
+		rules = append(rules, rule)
+	}
+
+	return zoho.NewValidator(string(module), rules), nil
+}
+
+// ValidateRecords checks the records of request before they are sent with InsertRecords (zoho.ValidateInsert)
+// or UpdateRecords (zoho.ValidateUpdate), invalid fields are returned as ValidationErrors
+func (c *API) ValidateRecords(request InsertRecordsData, module Module, mode zoho.ValidationMode) error {
+	v, err := c.NewValidator(module, "")
+	if err != nil {
+		return err
+	}
+	return v.Validate(request.Data, mode)
+}
@@ -0,0 +1,123 @@
+package zoho
+
+import "encoding/json"
+
+// SingleLine is the field type in Zoho that defines a single line input field
+type SingleLine string
+
+// UnmarshalJSON decodes a SingleLine, null is decoded as an empty string
+func (s *SingleLine) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = ""
+		return nil
+	}
+
+	var t string
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+
+	*s = SingleLine(t)
+	return nil
+}
+
+// MarshalJSON encodes a SingleLine as a JSON string
+func (s SingleLine) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+// MultiLine is the field type in Zoho that defines a multiline input field, like text area in HTML
+type MultiLine string
+
+// UnmarshalJSON decodes a MultiLine, null is decoded as an empty string
+func (s *MultiLine) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = ""
+		return nil
+	}
+
+	var t string
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+
+	*s = MultiLine(t)
+	return nil
+}
+
+// MarshalJSON encodes a MultiLine as a JSON string
+func (s MultiLine) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+// Email is the field type in Zoho that defines an email address field
+type Email string
+
+// UnmarshalJSON decodes a Email, null is decoded as an empty string
+func (s *Email) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = ""
+		return nil
+	}
+
+	var t string
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+
+	*s = Email(t)
+	return nil
+}
+
+// MarshalJSON encodes a Email as a JSON string
+func (s Email) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+// Phone is the field type in Zoho that defines a phone number field
+type Phone string
+
+// UnmarshalJSON decodes a Phone, null is decoded as an empty string
+func (s *Phone) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = ""
+		return nil
+	}
+
+	var t string
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+
+	*s = Phone(t)
+	return nil
+}
+
+// MarshalJSON encodes a Phone as a JSON string
+func (s Phone) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+// PickList is the field type in Zoho that defines a dropdown that has been selected
+type PickList string
+
+// UnmarshalJSON decodes a PickList, null is decoded as an empty string
+func (s *PickList) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = ""
+		return nil
+	}
+
+	var t string
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+
+	*s = PickList(t)
+	return nil
+}
+
+// MarshalJSON encodes a PickList as a JSON string
+func (s PickList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
@@ -0,0 +1,134 @@
+package finance
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListContactPersons will return a page of the contact persons of the contact specified by contactID
+// https://www.zoho.com/books/api/v3/contact-persons/#list-contact-persons
+func (c *Client) ListContactPersons(contactID string, params map[string]zoho.Parameter) (data ContactPersonsResponse, err error) {
+	endpoint := c.ListEndpoint(ContactPersonsModule, c.URL("%s/%s/%s", ContactsModule, contactID, ContactPersonsModule), &ContactPersonsResponse{}, params)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list contact persons of contact (%s)", contactID)); err != nil {
+		return ContactPersonsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ContactPersonsResponse); ok {
+		return *v, nil
+	}
+
+	return ContactPersonsResponse{}, fmt.Errorf("Data retrieved was not 'ContactPersonsResponse'")
+}
+
+// GetContactPerson will return the contact person specified by id of the contact contactID
+// https://www.zoho.com/books/api/v3/contact-persons/#get-a-contact-person
+func (c *Client) GetContactPerson(contactID string, id string) (data ContactPersonResponse, err error) {
+	endpoint := c.NewEndpoint(ContactPersonsModule, zoho.HTTPGet, c.URL("%s/%s/%s/%s", ContactsModule, contactID, ContactPersonsModule, id), &ContactPersonResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve contact person (%s)", id)); err != nil {
+		return ContactPersonResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ContactPersonResponse); ok {
+		return *v, nil
+	}
+
+	return ContactPersonResponse{}, fmt.Errorf("Data retrieved was not 'ContactPersonResponse'")
+}
+
+// CreateContactPerson will add the contact person in request to the contact request.ContactID
+// https://www.zoho.com/books/api/v3/contact-persons/#create-a-contact-person
+func (c *Client) CreateContactPerson(request ContactPerson) (data ContactPersonResponse, err error) {
+	endpoint := c.NewEndpoint(ContactPersonsModule, zoho.HTTPPost, c.URL("%s/%s", ContactsModule, ContactPersonsModule), &ContactPersonResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create contact person"); err != nil {
+		return ContactPersonResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ContactPersonResponse); ok {
+		return *v, nil
+	}
+
+	return ContactPersonResponse{}, fmt.Errorf("Data retrieved was not 'ContactPersonResponse'")
+}
+
+// UpdateContactPerson will update the contact person specified by id with request
+// https://www.zoho.com/books/api/v3/contact-persons/#update-a-contact-person
+func (c *Client) UpdateContactPerson(request ContactPerson, id string) (data ContactPersonResponse, err error) {
+	endpoint := c.NewEndpoint(ContactPersonsModule, zoho.HTTPPut, c.URL("%s/%s/%s", ContactsModule, ContactPersonsModule, id), &ContactPersonResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update contact person (%s)", id)); err != nil {
+		return ContactPersonResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ContactPersonResponse); ok {
+		return *v, nil
+	}
+
+	return ContactPersonResponse{}, fmt.Errorf("Data retrieved was not 'ContactPersonResponse'")
+}
+
+// DeleteContactPerson will delete the contact person specified by id
+// https://www.zoho.com/books/api/v3/contact-persons/#delete-a-contact-person
+func (c *Client) DeleteContactPerson(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ContactPersonsModule, zoho.HTTPDelete, c.URL("%s/%s/%s", ContactsModule, ContactPersonsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete contact person (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkPrimaryContactPerson will make the contact person specified by id the primary contact person of its contact
+// https://www.zoho.com/books/api/v3/contact-persons/#mark-as-primary-contact-person
+func (c *Client) MarkPrimaryContactPerson(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ContactPersonsModule, zoho.HTTPPost, c.URL("%s/%s/%s/primary", ContactsModule, ContactPersonsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark contact person (%s) as primary", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ContactPerson is a person of a contact, it is also the data provided to CreateContactPerson and UpdateContactPerson
+type ContactPerson struct {
+	ContactPersonID  string `json:"contact_person_id,omitempty"`
+	ContactID        string `json:"contact_id,omitempty"`
+	Salutation       string `json:"salutation,omitempty"`
+	FirstName        string `json:"first_name,omitempty"`
+	LastName         string `json:"last_name,omitempty"`
+	Email            string `json:"email,omitempty"`
+	Phone            string `json:"phone,omitempty"`
+	Mobile           string `json:"mobile,omitempty"`
+	Designation      string `json:"designation,omitempty"`
+	Department       string `json:"department,omitempty"`
+	Skype            string `json:"skype,omitempty"`
+	IsPrimaryContact bool   `json:"is_primary_contact,omitempty"`
+	EnablePortal     bool   `json:"enable_portal,omitempty"`
+	IsAddedInPortal  *bool  `json:"is_added_in_portal,omitempty"`
+}
+
+// ContactPersonsResponse is the data returned by ListContactPersons
+type ContactPersonsResponse struct {
+	Response
+	ContactPersons []ContactPerson `json:"contact_persons,omitempty"`
+	PageContext    PageContext     `json:"page_context,omitempty"`
+}
+
+// ContactPersonResponse is the data returned by GetContactPerson, CreateContactPerson and UpdateContactPerson
+type ContactPersonResponse struct {
+	Response
+	ContactPerson ContactPerson `json:"contact_person,omitempty"`
+}
@@ -0,0 +1,240 @@
+package finance
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListContacts will return a page of the contacts of the organization, filtered and paged with params
+// (eg. 'contact_type', 'filter_by', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/contacts/#list-contacts
+func (c *Client) ListContacts(params map[string]zoho.Parameter) (data ContactsResponse, err error) {
+	endpoint := c.ListEndpoint(ContactsModule, c.URL(ContactsModule), &ContactsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list contacts"); err != nil {
+		return ContactsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ContactsResponse); ok {
+		return *v, nil
+	}
+
+	return ContactsResponse{}, fmt.Errorf("Data retrieved was not 'ContactsResponse'")
+}
+
+// GetContact will return the contact specified by id
+// https://www.zoho.com/books/api/v3/contacts/#get-contact
+func (c *Client) GetContact(id string) (data ContactResponse, err error) {
+	endpoint := c.NewEndpoint(ContactsModule, zoho.HTTPGet, c.URL("%s/%s", ContactsModule, id), &ContactResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve contact (%s)", id)); err != nil {
+		return ContactResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ContactResponse); ok {
+		return *v, nil
+	}
+
+	return ContactResponse{}, fmt.Errorf("Data retrieved was not 'ContactResponse'")
+}
+
+// CreateContact will create the contact in request, ContactName is required
+// https://www.zoho.com/books/api/v3/contacts/#create-a-contact
+func (c *Client) CreateContact(request ContactRequest) (data ContactResponse, err error) {
+	endpoint := c.NewEndpoint(ContactsModule, zoho.HTTPPost, c.URL(ContactsModule), &ContactResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create contact"); err != nil {
+		return ContactResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ContactResponse); ok {
+		return *v, nil
+	}
+
+	return ContactResponse{}, fmt.Errorf("Data retrieved was not 'ContactResponse'")
+}
+
+// UpdateContact will update the contact specified by id with request
+// https://www.zoho.com/books/api/v3/contacts/#update-a-contact
+func (c *Client) UpdateContact(request ContactRequest, id string) (data ContactResponse, err error) {
+	endpoint := c.NewEndpoint(ContactsModule, zoho.HTTPPut, c.URL("%s/%s", ContactsModule, id), &ContactResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update contact (%s)", id)); err != nil {
+		return ContactResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ContactResponse); ok {
+		return *v, nil
+	}
+
+	return ContactResponse{}, fmt.Errorf("Data retrieved was not 'ContactResponse'")
+}
+
+// DeleteContact will delete the contact specified by id, contacts with transactions cannot be deleted
+// https://www.zoho.com/books/api/v3/contacts/#delete-a-contact
+func (c *Client) DeleteContact(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ContactsModule, zoho.HTTPDelete, c.URL("%s/%s", ContactsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete contact (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkContactActive will mark the contact specified by id as active
+// https://www.zoho.com/books/api/v3/contacts/#mark-as-active
+func (c *Client) MarkContactActive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ContactsModule, zoho.HTTPPost, c.URL("%s/%s/active", ContactsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark contact (%s) as active", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkContactInactive will mark the contact specified by id as inactive
+// https://www.zoho.com/books/api/v3/contacts/#mark-as-inactive
+func (c *Client) MarkContactInactive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ContactsModule, zoho.HTTPPost, c.URL("%s/%s/inactive", ContactsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark contact (%s) as inactive", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ContactType is the type of a contact
+type ContactType string
+
+const (
+	// CustomerContact - a contact invoices are raised for
+	CustomerContact ContactType = "customer"
+	// VendorContact - a contact bills are received from
+	VendorContact ContactType = "vendor"
+)
+
+// ContactRequest is the data provided to CreateContact and UpdateContact
+type ContactRequest struct {
+	ContactName       string          `json:"contact_name,omitempty"`
+	CompanyName       string          `json:"company_name,omitempty"`
+	ContactType       ContactType     `json:"contact_type,omitempty"`
+	CustomerSubType   string          `json:"customer_sub_type,omitempty"`
+	Website           string          `json:"website,omitempty"`
+	LanguageCode      string          `json:"language_code,omitempty"`
+	CreditLimit       float64         `json:"credit_limit,omitempty"`
+	CurrencyID        string          `json:"currency_id,omitempty"`
+	PaymentTerms      int             `json:"payment_terms,omitempty"`
+	PaymentTermsLabel string          `json:"payment_terms_label,omitempty"`
+	Notes             string          `json:"notes,omitempty"`
+	BillingAddress    *Address        `json:"billing_address,omitempty"`
+	ShippingAddress   *Address        `json:"shipping_address,omitempty"`
+	ContactPersons    []ContactPerson `json:"contact_persons,omitempty"`
+	CustomFields      []CustomField   `json:"custom_fields,omitempty"`
+	OwnerID           string          `json:"owner_id,omitempty"`
+	IsTaxable         *bool           `json:"is_taxable,omitempty"`
+	TaxID             string          `json:"tax_id,omitempty"`
+	TaxAuthorityID    string          `json:"tax_authority_id,omitempty"`
+	TaxExemptionID    string          `json:"tax_exemption_id,omitempty"`
+	VATRegNo          string          `json:"vat_reg_no,omitempty"`
+	GSTNo             string          `json:"gst_no,omitempty"`
+	GSTTreatment      string          `json:"gst_treatment,omitempty"`
+	PlaceOfContact    string          `json:"place_of_contact,omitempty"`
+	Facebook          string          `json:"facebook,omitempty"`
+	Twitter           string          `json:"twitter,omitempty"`
+	// DefaultTemplates are the templates used for the transactions of the contact
+	DefaultTemplates *DefaultTemplates `json:"default_templates,omitempty"`
+}
+
+// DefaultTemplates are the PDF and email templates used for the transactions of a contact
+type DefaultTemplates struct {
+	InvoiceTemplateID           string `json:"invoice_template_id,omitempty"`
+	InvoiceTemplateName         string `json:"invoice_template_name,omitempty"`
+	EstimateTemplateID          string `json:"estimate_template_id,omitempty"`
+	EstimateTemplateName        string `json:"estimate_template_name,omitempty"`
+	CreditnoteTemplateID        string `json:"creditnote_template_id,omitempty"`
+	CreditnoteTemplateName      string `json:"creditnote_template_name,omitempty"`
+	InvoiceEmailTemplateID      string `json:"invoice_email_template_id,omitempty"`
+	InvoiceEmailTemplateName    string `json:"invoice_email_template_name,omitempty"`
+	EstimateEmailTemplateID     string `json:"estimate_email_template_id,omitempty"`
+	EstimateEmailTemplateName   string `json:"estimate_email_template_name,omitempty"`
+	CreditnoteEmailTemplateID   string `json:"creditnote_email_template_id,omitempty"`
+	CreditnoteEmailTemplateName string `json:"creditnote_email_template_name,omitempty"`
+}
+
+// Contact is a customer or vendor of the organization
+type Contact struct {
+	ContactID                     string          `json:"contact_id,omitempty"`
+	ContactName                   string          `json:"contact_name,omitempty"`
+	CompanyName                   string          `json:"company_name,omitempty"`
+	ContactType                   ContactType     `json:"contact_type,omitempty"`
+	CustomerSubType               string          `json:"customer_sub_type,omitempty"`
+	Status                        string          `json:"status,omitempty"`
+	Website                       string          `json:"website,omitempty"`
+	LanguageCode                  string          `json:"language_code,omitempty"`
+	FirstName                     string          `json:"first_name,omitempty"`
+	LastName                      string          `json:"last_name,omitempty"`
+	Email                         string          `json:"email,omitempty"`
+	Phone                         string          `json:"phone,omitempty"`
+	Mobile                        string          `json:"mobile,omitempty"`
+	PrimaryContactID              string          `json:"primary_contact_id,omitempty"`
+	HasTransaction                bool            `json:"has_transaction,omitempty"`
+	IsLinkedWithZohoCRM           bool            `json:"is_linked_with_zohocrm,omitempty"`
+	CreditLimit                   float64         `json:"credit_limit,omitempty"`
+	PaymentTerms                  int             `json:"payment_terms,omitempty"`
+	PaymentTermsLabel             string          `json:"payment_terms_label,omitempty"`
+	CurrencyID                    string          `json:"currency_id,omitempty"`
+	CurrencyCode                  string          `json:"currency_code,omitempty"`
+	CurrencySymbol                string          `json:"currency_symbol,omitempty"`
+	OutstandingReceivableAmount   float64         `json:"outstanding_receivable_amount,omitempty"`
+	OutstandingPayableAmount      float64         `json:"outstanding_payable_amount,omitempty"`
+	UnusedCreditsReceivableAmount float64         `json:"unused_credits_receivable_amount,omitempty"`
+	UnusedCreditsPayableAmount    float64         `json:"unused_credits_payable_amount,omitempty"`
+	PaymentReminderEnabled        bool            `json:"payment_reminder_enabled,omitempty"`
+	BillingAddress                Address         `json:"billing_address,omitempty"`
+	ShippingAddress               Address         `json:"shipping_address,omitempty"`
+	ContactPersons                []ContactPerson `json:"contact_persons,omitempty"`
+	CustomFields                  []CustomField   `json:"custom_fields,omitempty"`
+	Notes                         string          `json:"notes,omitempty"`
+	OwnerID                       string          `json:"owner_id,omitempty"`
+	OwnerName                     string          `json:"owner_name,omitempty"`
+	IsTaxable                     bool            `json:"is_taxable,omitempty"`
+	TaxID                         string          `json:"tax_id,omitempty"`
+	TaxName                       string          `json:"tax_name,omitempty"`
+	TaxAuthorityID                string          `json:"tax_authority_id,omitempty"`
+	TaxExemptionID                string          `json:"tax_exemption_id,omitempty"`
+	VATRegNo                      string          `json:"vat_reg_no,omitempty"`
+	GSTNo                         string          `json:"gst_no,omitempty"`
+	GSTTreatment                  string          `json:"gst_treatment,omitempty"`
+	PlaceOfContact                string          `json:"place_of_contact,omitempty"`
+	CreatedTime                   string          `json:"created_time,omitempty"`
+	LastModifiedTime              string          `json:"last_modified_time,omitempty"`
+}
+
+// ContactsResponse is the data returned by ListContacts
+type ContactsResponse struct {
+	Response
+	Contacts    []Contact   `json:"contacts,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// ContactResponse is the data returned by GetContact, CreateContact and UpdateContact
+type ContactResponse struct {
+	Response
+	Contact Contact `json:"contact,omitempty"`
+}
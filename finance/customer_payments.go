@@ -0,0 +1,151 @@
+package finance
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListCustomerPayments will return a page of the customer payments of the organization, filtered and paged with params
+// (eg. 'customer_id', 'payment_mode', 'date_start', 'date_end', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/customer-payments/#list-customer-payments
+func (c *Client) ListCustomerPayments(params map[string]zoho.Parameter) (data CustomerPaymentsResponse, err error) {
+	endpoint := c.ListEndpoint(CustomerPaymentsModule, c.URL(CustomerPaymentsModule), &CustomerPaymentsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list customer payments"); err != nil {
+		return CustomerPaymentsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomerPaymentsResponse); ok {
+		return *v, nil
+	}
+
+	return CustomerPaymentsResponse{}, fmt.Errorf("Data retrieved was not 'CustomerPaymentsResponse'")
+}
+
+// GetCustomerPayment will return the customer payment specified by id
+// https://www.zoho.com/books/api/v3/customer-payments/#retrieve-a-payment
+func (c *Client) GetCustomerPayment(id string) (data CustomerPaymentResponse, err error) {
+	endpoint := c.NewEndpoint(CustomerPaymentsModule, zoho.HTTPGet, c.URL("%s/%s", CustomerPaymentsModule, id), &CustomerPaymentResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve customer payment (%s)", id)); err != nil {
+		return CustomerPaymentResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomerPaymentResponse); ok {
+		return *v, nil
+	}
+
+	return CustomerPaymentResponse{}, fmt.Errorf("Data retrieved was not 'CustomerPaymentResponse'")
+}
+
+// CreateCustomerPayment will create the customer payment in request, the amount is applied to the invoices of request.Invoices and the remainder is kept as unused credit
+// https://www.zoho.com/books/api/v3/customer-payments/#create-a-payment
+func (c *Client) CreateCustomerPayment(request CustomerPaymentRequest) (data CustomerPaymentResponse, err error) {
+	endpoint := c.NewEndpoint(CustomerPaymentsModule, zoho.HTTPPost, c.URL(CustomerPaymentsModule), &CustomerPaymentResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create customer payment"); err != nil {
+		return CustomerPaymentResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomerPaymentResponse); ok {
+		return *v, nil
+	}
+
+	return CustomerPaymentResponse{}, fmt.Errorf("Data retrieved was not 'CustomerPaymentResponse'")
+}
+
+// UpdateCustomerPayment will update the customer payment specified by id with request
+// https://www.zoho.com/books/api/v3/customer-payments/#update-a-payment
+func (c *Client) UpdateCustomerPayment(request CustomerPaymentRequest, id string) (data CustomerPaymentResponse, err error) {
+	endpoint := c.NewEndpoint(CustomerPaymentsModule, zoho.HTTPPut, c.URL("%s/%s", CustomerPaymentsModule, id), &CustomerPaymentResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update customer payment (%s)", id)); err != nil {
+		return CustomerPaymentResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomerPaymentResponse); ok {
+		return *v, nil
+	}
+
+	return CustomerPaymentResponse{}, fmt.Errorf("Data retrieved was not 'CustomerPaymentResponse'")
+}
+
+// DeleteCustomerPayment will delete the customer payment specified by id
+// https://www.zoho.com/books/api/v3/customer-payments/#delete-a-payment
+func (c *Client) DeleteCustomerPayment(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(CustomerPaymentsModule, zoho.HTTPDelete, c.URL("%s/%s", CustomerPaymentsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete customer payment (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// CustomerPaymentRequest is the data provided to CreateCustomerPayment and UpdateCustomerPayment
+type CustomerPaymentRequest struct {
+	CustomerID      string           `json:"customer_id,omitempty"`
+	PaymentMode     string           `json:"payment_mode,omitempty"`
+	Amount          float64          `json:"amount,omitempty"`
+	Date            string           `json:"date,omitempty"`
+	ReferenceNumber string           `json:"reference_number,omitempty"`
+	Description     string           `json:"description,omitempty"`
+	Invoices        []AppliedInvoice `json:"invoices,omitempty"`
+	ExchangeRate    float64          `json:"exchange_rate,omitempty"`
+	BankCharges     float64          `json:"bank_charges,omitempty"`
+	// AccountID is the cash or bank account the payment is deposited to
+	AccountID    string        `json:"account_id,omitempty"`
+	CustomFields []CustomField `json:"custom_fields,omitempty"`
+}
+
+// AppliedInvoice is the amount of a payment or credit applied to an invoice, only InvoiceID and AmountApplied are used in requests
+type AppliedInvoice struct {
+	InvoiceID     string  `json:"invoice_id,omitempty"`
+	AmountApplied float64 `json:"amount_applied,omitempty"`
+	InvoiceNumber string  `json:"invoice_number,omitempty"`
+	Date          string  `json:"date,omitempty"`
+	Total         float64 `json:"total,omitempty"`
+	BalanceAmount float64 `json:"balance_amount,omitempty"`
+}
+
+// CustomerPayment is a payment received from a customer
+type CustomerPayment struct {
+	PaymentID        string           `json:"payment_id,omitempty"`
+	PaymentNumber    string           `json:"payment_number,omitempty"`
+	CustomerID       string           `json:"customer_id,omitempty"`
+	CustomerName     string           `json:"customer_name,omitempty"`
+	PaymentMode      string           `json:"payment_mode,omitempty"`
+	Date             string           `json:"date,omitempty"`
+	AccountID        string           `json:"account_id,omitempty"`
+	AccountName      string           `json:"account_name,omitempty"`
+	ReferenceNumber  string           `json:"reference_number,omitempty"`
+	Description      string           `json:"description,omitempty"`
+	CurrencyID       string           `json:"currency_id,omitempty"`
+	CurrencyCode     string           `json:"currency_code,omitempty"`
+	ExchangeRate     float64          `json:"exchange_rate,omitempty"`
+	Amount           float64          `json:"amount,omitempty"`
+	BankCharges      float64          `json:"bank_charges,omitempty"`
+	UnusedAmount     float64          `json:"unused_amount,omitempty"`
+	Invoices         []AppliedInvoice `json:"invoices,omitempty"`
+	CustomFields     []CustomField    `json:"custom_fields,omitempty"`
+	CreatedTime      string           `json:"created_time,omitempty"`
+	LastModifiedTime string           `json:"last_modified_time,omitempty"`
+}
+
+// CustomerPaymentsResponse is the data returned by ListCustomerPayments
+type CustomerPaymentsResponse struct {
+	Response
+	CustomerPayments []CustomerPayment `json:"customerpayments,omitempty"`
+	PageContext      PageContext       `json:"page_context,omitempty"`
+}
+
+// CustomerPaymentResponse is the data returned by GetCustomerPayment, CreateCustomerPayment and UpdateCustomerPayment
+type CustomerPaymentResponse struct {
+	Response
+	Payment CustomerPayment `json:"payment,omitempty"`
+}
@@ -0,0 +1,237 @@
+// Package finance is the core shared by Zoho Invoice and Zoho Books, whose APIs have the same shape: every request
+// is made for an organization, every response holds a code and a message, and the models and operations of contacts,
+// items, invoices and customer payments are the same.
+
+package finance
+
+import (
+	"fmt"
+	"strconv"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// Product is a Zoho finance product, it selects the API a Client sends its requests to
+type Product string
+
+// The finance products
+const (
+	ZohoInvoice Product = "invoice"
+	ZohoBooks   Product = "books"
+)
+
+// The modules shared by the finance products
+const (
+	ContactsModule         string = "contacts"
+	ContactPersonsModule   string = "contactpersons"
+	ItemsModule            string = "items"
+	InvoicesModule         string = "invoices"
+	CustomerPaymentsModule string = "customerpayments"
+)
+
+// Endpoint returns the base URL of the API of the product, with a %s for the TLD of the Zoho domain
+func (p Product) Endpoint() string {
+	return "https://www.zohoapis.%s/" + string(p) + "/v3/"
+}
+
+// OrganizationHeader returns the header holding the organization of every request made to the product
+func (p Product) OrganizationHeader() string {
+	return "X-com-zoho-" + string(p) + "-organizationid"
+}
+
+// Client is used for interacting with the API of a finance product, every Client picks its own product
+type Client struct {
+	*zoho.Zoho
+	Product Product
+	// OrganizationID is sent in the organization header of every request,
+	// when empty the OrganizationID of the embedded zoho.Zoho is used
+	OrganizationID string
+}
+
+// New returns a *finance.Client sending its requests to product, with the provided zoho.Zoho as an embedded field
+func New(z *zoho.Zoho, product Product) *Client {
+	return &Client{
+		Zoho:    z,
+		Product: product,
+	}
+}
+
+// Organization returns the organization requests are made for
+func (c *Client) Organization() string {
+	if c.OrganizationID != "" {
+		return c.OrganizationID
+	}
+	return c.Zoho.OrganizationID
+}
+
+// URL returns the URL of path in the API of the product
+func (c *Client) URL(path string, args ...interface{}) string {
+	return fmt.Sprintf(c.Product.Endpoint(), c.ZohoTLD) + fmt.Sprintf(path, args...)
+}
+
+// NewEndpoint returns an endpoint of module with the organization header set, a request body is sent as JSONString
+func (c *Client) NewEndpoint(module string, method zoho.HTTPMethod, url string, response interface{}, request interface{}) zoho.Endpoint {
+	endpoint := zoho.Endpoint{
+		Name:          module,
+		URL:           url,
+		Method:        method,
+		ResponseData:  response,
+		URLParameters: map[string]zoho.Parameter{},
+		Headers: map[string]string{
+			c.Product.OrganizationHeader(): c.Organization(),
+		},
+	}
+	if request != nil {
+		endpoint.RequestBody = request
+		endpoint.BodyFormat = zoho.JSON_STRING
+	}
+	return endpoint
+}
+
+// ListEndpoint returns an endpoint listing module, params override the paging defaults
+func (c *Client) ListEndpoint(module string, url string, response interface{}, params map[string]zoho.Parameter) zoho.Endpoint {
+	endpoint := c.NewEndpoint(module, zoho.HTTPGet, url, response, nil)
+	endpoint.URLParameters["page"] = ""
+	endpoint.URLParameters["per_page"] = "200"
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+	return endpoint
+}
+
+// Send performs the request of endpoint and checks the code returned when the response embeds Response
+func (c *Client) Send(endpoint *zoho.Endpoint, failure string) error {
+	err := c.Zoho.HTTPRequest(endpoint)
+	if err != nil {
+		return fmt.Errorf("%s: %s", failure, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(interface{ status() Response }); ok {
+		if s := v.status(); s.Code != 0 {
+			return &Error{Code: s.Code, Message: s.Message, Failure: failure}
+		}
+	}
+	return nil
+}
+
+// Response holds the code and message returned by every request, a code other than 0 is an error
+type Response struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (r *Response) status() Response {
+	return *r
+}
+
+// Error is returned when a request is answered with a code other than 0
+type Error struct {
+	Code    int
+	Message string
+	// Failure describes the operation which failed
+	Failure string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %d: %s", e.Failure, e.Code, e.Message)
+}
+
+// PageContext is the paging information returned by list requests
+type PageContext struct {
+	Page        int    `json:"page,omitempty"`
+	PerPage     int    `json:"per_page,omitempty"`
+	HasMorePage bool   `json:"has_more_page,omitempty"`
+	ReportName  string `json:"report_name,omitempty"`
+	SortColumn  string `json:"sort_column,omitempty"`
+	SortOrder   string `json:"sort_order,omitempty"`
+}
+
+// ForEachPage calls list with the 'page' parameter set to every page in turn, starting from the first,
+// until a page reports it is the last one or list returns an error. params are passed to every call.
+//
+//	var contacts []finance.Contact
+//	err := finance.ForEachPage(nil, func(params map[string]zoho.Parameter) (finance.PageContext, error) {
+//	    resp, err := c.ListContacts(params)
+//	    contacts = append(contacts, resp.Contacts...)
+//	    return resp.PageContext, err
+//	})
+func ForEachPage(params map[string]zoho.Parameter, list func(params map[string]zoho.Parameter) (PageContext, error)) error {
+	for page := 1; ; page++ {
+		p := map[string]zoho.Parameter{}
+		for k, v := range params {
+			p[k] = v
+		}
+		p["page"] = zoho.Parameter(strconv.Itoa(page))
+
+		ctx, err := list(p)
+		if err != nil {
+			return err
+		}
+		if !ctx.HasMorePage {
+			return nil
+		}
+	}
+}
+
+// CustomField is the value of a custom field, set either CustomFieldID or Label (or APIName) in requests
+type CustomField struct {
+	CustomFieldID string      `json:"customfield_id,omitempty"`
+	Label         string      `json:"label,omitempty"`
+	APIName       string      `json:"api_name,omitempty"`
+	Value         interface{} `json:"value,omitempty"`
+	DataType      string      `json:"data_type,omitempty"`
+	Index         int         `json:"index,omitempty"`
+	ShowOnPDF     bool        `json:"show_on_pdf,omitempty"`
+}
+
+// Address is a billing or shipping address
+type Address struct {
+	AddressID string `json:"address_id,omitempty"`
+	Attention string `json:"attention,omitempty"`
+	Address   string `json:"address,omitempty"`
+	Street2   string `json:"street2,omitempty"`
+	City      string `json:"city,omitempty"`
+	StateCode string `json:"state_code,omitempty"`
+	State     string `json:"state,omitempty"`
+	Zip       string `json:"zip,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+	Fax       string `json:"fax,omitempty"`
+}
+
+// LineItem is a line of a sales or purchase transaction (invoice, bill, purchase order...). In requests set either ItemID
+// or Name, Rate and Quantity, the totals are computed by Zoho.
+type LineItem struct {
+	LineItemID     string        `json:"line_item_id,omitempty"`
+	ItemID         string        `json:"item_id,omitempty"`
+	Name           string        `json:"name,omitempty"`
+	Description    string        `json:"description,omitempty"`
+	ItemOrder      int           `json:"item_order,omitempty"`
+	ItemType       string        `json:"item_type,omitempty"`
+	ProductType    string        `json:"product_type,omitempty"`
+	BcyRate        float64       `json:"bcy_rate,omitempty"`
+	Rate           float64       `json:"rate,omitempty"`
+	Quantity       float64       `json:"quantity,omitempty"`
+	Unit           string        `json:"unit,omitempty"`
+	Discount       interface{}   `json:"discount,omitempty"`
+	DiscountAmount float64       `json:"discount_amount,omitempty"`
+	TaxID          string        `json:"tax_id,omitempty"`
+	TaxExemptionID string        `json:"tax_exemption_id,omitempty"`
+	TaxName        string        `json:"tax_name,omitempty"`
+	TaxType        string        `json:"tax_type,omitempty"`
+	TaxPercentage  float64       `json:"tax_percentage,omitempty"`
+	AccountID      string        `json:"account_id,omitempty"`
+	ProjectID      string        `json:"project_id,omitempty"`
+	ProjectName    string        `json:"project_name,omitempty"`
+	TimeEntryIDs   []string      `json:"time_entry_ids,omitempty"`
+	ExpenseID      string        `json:"expense_id,omitempty"`
+	HSNOrSAC       string        `json:"hsn_or_sac,omitempty"`
+	ItemTotal      float64       `json:"item_total,omitempty"`
+	CustomFields   []CustomField `json:"item_custom_fields,omitempty"`
+}
+
+// Tax is the total of a tax applied to a transaction
+type Tax struct {
+	TaxName   string  `json:"tax_name,omitempty"`
+	TaxAmount float64 `json:"tax_amount,omitempty"`
+}
@@ -0,0 +1,671 @@
+package finance
+
+import (
+	"fmt"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListInvoices will return a page of the invoices of the organization, filtered and paged with params
+// (eg. 'customer_id', 'status', 'date_start', 'date_end', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/invoices/#list-invoices
+func (c *Client) ListInvoices(params map[string]zoho.Parameter) (data InvoicesResponse, err error) {
+	endpoint := c.ListEndpoint(InvoicesModule, c.URL(InvoicesModule), &InvoicesResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list invoices"); err != nil {
+		return InvoicesResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoicesResponse); ok {
+		return *v, nil
+	}
+
+	return InvoicesResponse{}, fmt.Errorf("Data retrieved was not 'InvoicesResponse'")
+}
+
+// GetInvoice will return the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#get-an-invoice
+func (c *Client) GetInvoice(id string) (data InvoiceResponse, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPGet, c.URL("%s/%s", InvoicesModule, id), &InvoiceResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve invoice (%s)", id)); err != nil {
+		return InvoiceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceResponse); ok {
+		return *v, nil
+	}
+
+	return InvoiceResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceResponse'")
+}
+
+// CreateInvoice will create the invoice in request as a draft, CustomerID and LineItems are required
+// https://www.zoho.com/books/api/v3/invoices/#create-an-invoice
+func (c *Client) CreateInvoice(request InvoiceRequest) (data InvoiceResponse, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL(InvoicesModule), &InvoiceResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create invoice"); err != nil {
+		return InvoiceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceResponse); ok {
+		return *v, nil
+	}
+
+	return InvoiceResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceResponse'")
+}
+
+// UpdateInvoice will update the invoice specified by id with request
+// https://www.zoho.com/books/api/v3/invoices/#update-an-invoice
+func (c *Client) UpdateInvoice(request InvoiceRequest, id string) (data InvoiceResponse, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPut, c.URL("%s/%s", InvoicesModule, id), &InvoiceResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update invoice (%s)", id)); err != nil {
+		return InvoiceResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceResponse); ok {
+		return *v, nil
+	}
+
+	return InvoiceResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceResponse'")
+}
+
+// DeleteInvoice will delete the invoice specified by id, invoices with payments or credits applied cannot be deleted
+// https://www.zoho.com/books/api/v3/invoices/#delete-an-invoice
+func (c *Client) DeleteInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPDelete, c.URL("%s/%s", InvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkInvoiceSent will mark the invoice specified by id as sent
+// https://www.zoho.com/books/api/v3/invoices/#mark-an-invoice-as-sent
+func (c *Client) MarkInvoiceSent(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/status/sent", InvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark invoice (%s) as sent", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// VoidInvoice will mark the invoice specified by id as void, its payments and credits are released
+// https://www.zoho.com/books/api/v3/invoices/#void-an-invoice
+func (c *Client) VoidInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/status/void", InvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark invoice (%s) as void", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkInvoiceDraft will mark the invoice specified by id as draft, only void invoices can be marked as draft
+// https://www.zoho.com/books/api/v3/invoices/#mark-as-draft
+func (c *Client) MarkInvoiceDraft(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/status/draft", InvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark invoice (%s) as draft", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// EmailInvoice will email the invoice specified by id to the recipients of request, by default to the contact persons of the customer
+// https://www.zoho.com/books/api/v3/invoices/#email-an-invoice
+func (c *Client) EmailInvoice(request EmailRequest, id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/email", InvoicesModule, id), &Response{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to email invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ApplyCreditsToInvoice will apply the unused payments (eg. paid retainer invoices) and credit notes of request
+// to the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#apply-credits
+func (c *Client) ApplyCreditsToInvoice(request InvoiceCreditsRequest, id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/credits", InvoicesModule, id), &Response{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to apply credits to invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// SubmitInvoice will submit the invoice specified by id for approval
+// https://www.zoho.com/books/api/v3/invoices/#submit-an-invoice-for-approval
+func (c *Client) SubmitInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/submit", InvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to submit invoice (%s) for approval", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ApproveInvoice will approve the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#approve-an-invoice
+func (c *Client) ApproveInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/approve", InvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to approve invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// WriteOffInvoice will write off the balance of the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#write-off-invoice
+func (c *Client) WriteOffInvoice(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/writeoff", InvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to write off invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// CancelInvoiceWriteOff will cancel the write off of the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#cancel-write-off
+func (c *Client) CancelInvoiceWriteOff(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/writeoff/cancel", InvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to cancel the write off of invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// SendPaymentReminder will email a reminder of the payment of the invoice specified by id to the recipients of request,
+// by default to the contact persons of the customer
+// https://www.zoho.com/books/api/v3/invoices/#email-payment-reminder
+func (c *Client) SendPaymentReminder(request EmailRequest, id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/paymentreminder", InvoicesModule, id), &Response{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to send a payment reminder for invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// SendPaymentReminders will email a reminder of the payment of every invoice of ids (10 at most)
+// to the contact persons of their customers
+// https://www.zoho.com/books/api/v3/invoices/#bulk-invoice-reminder
+func (c *Client) SendPaymentReminders(ids ...string) (data Response, err error) {
+	if len(ids) == 0 || len(ids) > 10 {
+		return Response{}, fmt.Errorf("Failed to send payment reminders, must provide between 1 and 10 IDs, %d provided", len(ids))
+	}
+
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/paymentreminder", InvoicesModule), &Response{}, nil)
+	endpoint.URLParameters["invoice_ids"] = zoho.Parameter(strings.Join(ids, ","))
+
+	if err = c.Send(&endpoint, "Failed to send payment reminders"); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// EnableInvoicePaymentReminder will enable the automated payment reminders of the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#enable-payment-reminder
+func (c *Client) EnableInvoicePaymentReminder(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/paymentreminder/enable", InvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to enable the payment reminders of invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// DisableInvoicePaymentReminder will disable the automated payment reminders of the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#disable-payment-reminder
+func (c *Client) DisableInvoicePaymentReminder(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/paymentreminder/disable", InvoicesModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to disable the payment reminders of invoice (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ListInvoicePayments will return the payments made for the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#list-invoice-payments
+func (c *Client) ListInvoicePayments(id string) (data InvoicePaymentsResponse, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPGet, c.URL("%s/%s/payments", InvoicesModule, id), &InvoicePaymentsResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list the payments of invoice (%s)", id)); err != nil {
+		return InvoicePaymentsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoicePaymentsResponse); ok {
+		return *v, nil
+	}
+
+	return InvoicePaymentsResponse{}, fmt.Errorf("Data retrieved was not 'InvoicePaymentsResponse'")
+}
+
+// DeleteInvoicePayment will delete the payment invoicePaymentID made for the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#delete-a-payment
+func (c *Client) DeleteInvoicePayment(id string, invoicePaymentID string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPDelete, c.URL("%s/%s/payments/%s", InvoicesModule, id, invoicePaymentID), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete payment (%s) of invoice (%s)", invoicePaymentID, id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ListInvoiceCreditsApplied will return the credit notes applied to the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#list-credits-applied
+func (c *Client) ListInvoiceCreditsApplied(id string) (data InvoiceCreditsAppliedResponse, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPGet, c.URL("%s/%s/creditsapplied", InvoicesModule, id), &InvoiceCreditsAppliedResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list the credits applied to invoice (%s)", id)); err != nil {
+		return InvoiceCreditsAppliedResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceCreditsAppliedResponse); ok {
+		return *v, nil
+	}
+
+	return InvoiceCreditsAppliedResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceCreditsAppliedResponse'")
+}
+
+// DeleteInvoiceCreditApplied will remove the credit note creditnotesInvoiceID applied to the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#delete-applied-credit
+func (c *Client) DeleteInvoiceCreditApplied(id string, creditnotesInvoiceID string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPDelete, c.URL("%s/%s/creditsapplied/%s", InvoicesModule, id, creditnotesInvoiceID), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete credit (%s) applied to invoice (%s)", creditnotesInvoiceID, id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ListInvoiceComments will return the comments and history of the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#list-invoice-comments-and-history
+func (c *Client) ListInvoiceComments(id string) (data InvoiceCommentsResponse, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPGet, c.URL("%s/%s/comments", InvoicesModule, id), &InvoiceCommentsResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to list the comments of invoice (%s)", id)); err != nil {
+		return InvoiceCommentsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceCommentsResponse); ok {
+		return *v, nil
+	}
+
+	return InvoiceCommentsResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceCommentsResponse'")
+}
+
+// AddInvoiceComment will add the comment in request to the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#add-comment
+func (c *Client) AddInvoiceComment(request CommentRequest, id string) (data InvoiceCommentResponse, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPost, c.URL("%s/%s/comments", InvoicesModule, id), &InvoiceCommentResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to add comment to invoice (%s)", id)); err != nil {
+		return InvoiceCommentResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceCommentResponse); ok {
+		return *v, nil
+	}
+
+	return InvoiceCommentResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceCommentResponse'")
+}
+
+// UpdateInvoiceComment will update the comment commentID of the invoice specified by id with request
+// https://www.zoho.com/books/api/v3/invoices/#update-comment
+func (c *Client) UpdateInvoiceComment(request CommentRequest, id string, commentID string) (data InvoiceCommentResponse, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPPut, c.URL("%s/%s/comments/%s", InvoicesModule, id, commentID), &InvoiceCommentResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update comment (%s) of invoice (%s)", commentID, id)); err != nil {
+		return InvoiceCommentResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceCommentResponse); ok {
+		return *v, nil
+	}
+
+	return InvoiceCommentResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceCommentResponse'")
+}
+
+// DeleteInvoiceComment will delete the comment commentID of the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#delete-a-comment
+func (c *Client) DeleteInvoiceComment(id string, commentID string) (data Response, err error) {
+	endpoint := c.NewEndpoint(InvoicesModule, zoho.HTTPDelete, c.URL("%s/%s/comments/%s", InvoicesModule, id, commentID), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete comment (%s) of invoice (%s)", commentID, id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// InvoiceRequest is the data provided to CreateInvoice and UpdateInvoice
+type InvoiceRequest struct {
+	CustomerID            string          `json:"customer_id,omitempty"`
+	ContactPersons        []string        `json:"contact_persons,omitempty"`
+	InvoiceNumber         string          `json:"invoice_number,omitempty"`
+	ReferenceNumber       string          `json:"reference_number,omitempty"`
+	TemplateID            string          `json:"template_id,omitempty"`
+	Date                  string          `json:"date,omitempty"`
+	PaymentTerms          int             `json:"payment_terms,omitempty"`
+	PaymentTermsLabel     string          `json:"payment_terms_label,omitempty"`
+	DueDate               string          `json:"due_date,omitempty"`
+	CurrencyID            string          `json:"currency_id,omitempty"`
+	ExchangeRate          float64         `json:"exchange_rate,omitempty"`
+	Discount              interface{}     `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool            `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string          `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool            `json:"is_inclusive_tax,omitempty"`
+	RecurringInvoiceID    string          `json:"recurring_invoice_id,omitempty"`
+	InvoicedEstimateID    string          `json:"invoiced_estimate_id,omitempty"`
+	SalespersonID         string          `json:"salesperson_id,omitempty"`
+	SalespersonName       string          `json:"salesperson_name,omitempty"`
+	ProjectID             string          `json:"project_id,omitempty"`
+	LineItems             []LineItem      `json:"line_items,omitempty"`
+	PaymentOptions        *PaymentOptions `json:"payment_options,omitempty"`
+	AllowPartialPayments  bool            `json:"allow_partial_payments,omitempty"`
+	CustomBody            string          `json:"custom_body,omitempty"`
+	CustomSubject         string          `json:"custom_subject,omitempty"`
+	Notes                 string          `json:"notes,omitempty"`
+	Terms                 string          `json:"terms,omitempty"`
+	ShippingCharge        float64         `json:"shipping_charge,omitempty"`
+	Adjustment            float64         `json:"adjustment,omitempty"`
+	AdjustmentDescription string          `json:"adjustment_description,omitempty"`
+	Reason                string          `json:"reason,omitempty"`
+	BillingAddressID      string          `json:"billing_address_id,omitempty"`
+	ShippingAddressID     string          `json:"shipping_address_id,omitempty"`
+	TaxAuthorityID        string          `json:"tax_authority_id,omitempty"`
+	TaxExemptionID        string          `json:"tax_exemption_id,omitempty"`
+	PlaceOfSupply         string          `json:"place_of_supply,omitempty"`
+	GSTTreatment          string          `json:"gst_treatment,omitempty"`
+	GSTNo                 string          `json:"gst_no,omitempty"`
+	CustomFields          []CustomField   `json:"custom_fields,omitempty"`
+}
+
+// PaymentOptions are the online payment gateways offered on an invoice
+type PaymentOptions struct {
+	PaymentGateways []PaymentGateway `json:"payment_gateways,omitempty"`
+}
+
+// PaymentGateway is an online payment gateway
+type PaymentGateway struct {
+	Configured           bool   `json:"configured,omitempty"`
+	AdditionalField1     string `json:"additional_field1,omitempty"`
+	GatewayName          string `json:"gateway_name,omitempty"`
+	GatewayNameFormatted string `json:"gateway_name_formatted,omitempty"`
+}
+
+// Invoice is an invoice raised for a customer
+type Invoice struct {
+	InvoiceID             string          `json:"invoice_id,omitempty"`
+	InvoiceNumber         string          `json:"invoice_number,omitempty"`
+	Status                string          `json:"status,omitempty"`
+	CustomerID            string          `json:"customer_id,omitempty"`
+	CustomerName          string          `json:"customer_name,omitempty"`
+	ContactPersons        []string        `json:"contact_persons,omitempty"`
+	ReferenceNumber       string          `json:"reference_number,omitempty"`
+	TemplateID            string          `json:"template_id,omitempty"`
+	Date                  string          `json:"date,omitempty"`
+	DueDate               string          `json:"due_date,omitempty"`
+	PaymentTerms          int             `json:"payment_terms,omitempty"`
+	PaymentTermsLabel     string          `json:"payment_terms_label,omitempty"`
+	PaymentExpectedDate   string          `json:"payment_expected_date,omitempty"`
+	LastPaymentDate       string          `json:"last_payment_date,omitempty"`
+	CurrencyID            string          `json:"currency_id,omitempty"`
+	CurrencyCode          string          `json:"currency_code,omitempty"`
+	ExchangeRate          float64         `json:"exchange_rate,omitempty"`
+	Discount              float64         `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool            `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string          `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool            `json:"is_inclusive_tax,omitempty"`
+	RecurringInvoiceID    string          `json:"recurring_invoice_id,omitempty"`
+	SalespersonID         string          `json:"salesperson_id,omitempty"`
+	SalespersonName       string          `json:"salesperson_name,omitempty"`
+	ProjectID             string          `json:"project_id,omitempty"`
+	LineItems             []LineItem      `json:"line_items,omitempty"`
+	ShippingCharge        float64         `json:"shipping_charge,omitempty"`
+	Adjustment            float64         `json:"adjustment,omitempty"`
+	AdjustmentDescription string          `json:"adjustment_description,omitempty"`
+	SubTotal              float64         `json:"sub_total,omitempty"`
+	TaxTotal              float64         `json:"tax_total,omitempty"`
+	Total                 float64         `json:"total,omitempty"`
+	Taxes                 []Tax           `json:"taxes,omitempty"`
+	PaymentMade           float64         `json:"payment_made,omitempty"`
+	CreditsApplied        float64         `json:"credits_applied,omitempty"`
+	WriteOffAmount        float64         `json:"write_off_amount,omitempty"`
+	Balance               float64         `json:"balance,omitempty"`
+	AllowPartialPayments  bool            `json:"allow_partial_payments,omitempty"`
+	PaymentOptions        *PaymentOptions `json:"payment_options,omitempty"`
+	IsEmailed             bool            `json:"is_emailed,omitempty"`
+	IsViewedByClient      bool            `json:"is_viewed_by_client,omitempty"`
+	HasAttachment         bool            `json:"has_attachment,omitempty"`
+	RemindersSent         int             `json:"reminders_sent,omitempty"`
+	BillingAddress        Address         `json:"billing_address,omitempty"`
+	ShippingAddress       Address         `json:"shipping_address,omitempty"`
+	Notes                 string          `json:"notes,omitempty"`
+	Terms                 string          `json:"terms,omitempty"`
+	CustomFields          []CustomField   `json:"custom_fields,omitempty"`
+	InvoiceURL            string          `json:"invoice_url,omitempty"`
+	CreatedTime           string          `json:"created_time,omitempty"`
+	LastModifiedTime      string          `json:"last_modified_time,omitempty"`
+	// The fields below are returned by Zoho Invoice
+	AchPaymentInitiated    bool    `json:"ach_payment_initiated,omitempty"`
+	IsPreGST               bool    `json:"is_pre_gst,omitempty"`
+	PlaceOfSupply          string  `json:"place_of_supply,omitempty"`
+	GSTNo                  string  `json:"gst_no,omitempty"`
+	GSTTreatment           string  `json:"gst_treatment,omitempty"`
+	ClientViewedTime       string  `json:"client_viewed_time,omitempty"`
+	PaymentReminderEnabled bool    `json:"payment_reminder_enabled,omitempty"`
+	TaxAmountWithheld      float64 `json:"tax_amount_withheld,omitempty"`
+	PricePrecision         int     `json:"price_precision,omitempty"`
+	LastReminderSentDate   string  `json:"last_reminder_sent_date,omitempty"`
+	TemplateName           string  `json:"template_name,omitempty"`
+	AttachmentName         string  `json:"attachment_name,omitempty"`
+	CanSendInMail          bool    `json:"can_send_in_mail,omitempty"`
+}
+
+// InvoicesResponse is the data returned by ListInvoices
+type InvoicesResponse struct {
+	Response
+	Invoices    []Invoice   `json:"invoices,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// InvoiceResponse is the data returned by GetInvoice, CreateInvoice and UpdateInvoice
+type InvoiceResponse struct {
+	Response
+	Invoice Invoice `json:"invoice,omitempty"`
+}
+
+// EmailRequest is the data provided when emailing a transaction, empty fields use the defaults of the organization
+type EmailRequest struct {
+	SendFromOrgEmailID bool     `json:"send_from_org_email_id,omitempty"`
+	ToMailIDs          []string `json:"to_mail_ids,omitempty"`
+	CCMailIDs          []string `json:"cc_mail_ids,omitempty"`
+	Subject            string   `json:"subject,omitempty"`
+	Body               string   `json:"body,omitempty"`
+}
+
+// InvoiceCreditsRequest is the data provided to ApplyCreditsToInvoice
+type InvoiceCreditsRequest struct {
+	InvoicePayments  []AppliedPayment    `json:"invoice_payments,omitempty"`
+	ApplyCreditNotes []AppliedCreditNote `json:"apply_creditnotes,omitempty"`
+}
+
+// AppliedPayment is the amount of a payment applied to a bill or an invoice
+type AppliedPayment struct {
+	PaymentID     string  `json:"payment_id"`
+	AmountApplied float64 `json:"amount_applied"`
+}
+
+// AppliedCreditNote is the amount of a credit note applied to an invoice
+type AppliedCreditNote struct {
+	CreditNoteID  string  `json:"creditnote_id"`
+	AmountApplied float64 `json:"amount_applied"`
+}
+
+// InvoicePayment is a payment made for an invoice
+type InvoicePayment struct {
+	PaymentID              string  `json:"payment_id,omitempty"`
+	PaymentNumber          string  `json:"payment_number,omitempty"`
+	InvoiceID              string  `json:"invoice_id,omitempty"`
+	InvoicePaymentID       string  `json:"invoice_payment_id,omitempty"`
+	PaymentMode            string  `json:"payment_mode,omitempty"`
+	Description            string  `json:"description,omitempty"`
+	Date                   string  `json:"date,omitempty"`
+	ReferenceNumber        string  `json:"reference_number,omitempty"`
+	ExchangeRate           float64 `json:"exchange_rate,omitempty"`
+	Amount                 float64 `json:"amount,omitempty"`
+	TaxAmountWithheld      float64 `json:"tax_amount_withheld,omitempty"`
+	OnlineTransactionID    string  `json:"online_transaction_id,omitempty"`
+	IsSingleInvoicePayment bool    `json:"is_single_invoice_payment,omitempty"`
+}
+
+// InvoicePaymentsResponse is the data returned by ListInvoicePayments
+type InvoicePaymentsResponse struct {
+	Response
+	Payments []InvoicePayment `json:"payments,omitempty"`
+}
+
+// AppliedCredit is a credit note applied to an invoice
+type AppliedCredit struct {
+	CreditnoteID         string  `json:"creditnote_id,omitempty"`
+	CreditnotesInvoiceID string  `json:"creditnotes_invoice_id,omitempty"`
+	CreditnotesNumber    string  `json:"creditnotes_number,omitempty"`
+	CreditedDate         string  `json:"credited_date,omitempty"`
+	AmountApplied        float64 `json:"amount_applied,omitempty"`
+}
+
+// InvoiceCreditsAppliedResponse is the data returned by ListInvoiceCreditsApplied
+type InvoiceCreditsAppliedResponse struct {
+	Response
+	Credits []AppliedCredit `json:"credits,omitempty"`
+}
+
+// CommentRequest is the data provided to AddInvoiceComment and UpdateInvoiceComment
+type CommentRequest struct {
+	Description          string `json:"description"`
+	ShowCommentToClients bool   `json:"show_comment_to_clients,omitempty"`
+}
+
+// Comment is a comment, or an entry of the history, of a transaction
+type Comment struct {
+	CommentID            string `json:"comment_id,omitempty"`
+	InvoiceID            string `json:"invoice_id,omitempty"`
+	Description          string `json:"description,omitempty"`
+	CommentedByID        string `json:"commented_by_id,omitempty"`
+	CommentedBy          string `json:"commented_by,omitempty"`
+	CommentType          string `json:"comment_type,omitempty"`
+	OperationType        string `json:"operation_type,omitempty"`
+	TransactionID        string `json:"transaction_id,omitempty"`
+	TransactionType      string `json:"transaction_type,omitempty"`
+	Date                 string `json:"date,omitempty"`
+	DateDescription      string `json:"date_description,omitempty"`
+	Time                 string `json:"time,omitempty"`
+	ShowCommentToClients bool   `json:"show_comment_to_clients,omitempty"`
+}
+
+// InvoiceCommentsResponse is the data returned by ListInvoiceComments
+type InvoiceCommentsResponse struct {
+	Response
+	Comments []Comment `json:"comments,omitempty"`
+}
+
+// InvoiceCommentResponse is the data returned by AddInvoiceComment and UpdateInvoiceComment
+type InvoiceCommentResponse struct {
+	Response
+	Comment Comment `json:"comment,omitempty"`
+}
@@ -0,0 +1,191 @@
+package finance
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ListItems will return a page of the items of the organization, filtered and paged with params
+// (eg. 'name', 'filter_by', 'search_text', 'page', 'per_page')
+// https://www.zoho.com/books/api/v3/items/#list-items
+func (c *Client) ListItems(params map[string]zoho.Parameter) (data ItemsResponse, err error) {
+	endpoint := c.ListEndpoint(ItemsModule, c.URL(ItemsModule), &ItemsResponse{}, params)
+
+	if err = c.Send(&endpoint, "Failed to list items"); err != nil {
+		return ItemsResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ItemsResponse); ok {
+		return *v, nil
+	}
+
+	return ItemsResponse{}, fmt.Errorf("Data retrieved was not 'ItemsResponse'")
+}
+
+// GetItem will return the item specified by id
+// https://www.zoho.com/books/api/v3/items/#get-an-item
+func (c *Client) GetItem(id string) (data ItemResponse, err error) {
+	endpoint := c.NewEndpoint(ItemsModule, zoho.HTTPGet, c.URL("%s/%s", ItemsModule, id), &ItemResponse{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to retrieve item (%s)", id)); err != nil {
+		return ItemResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ItemResponse); ok {
+		return *v, nil
+	}
+
+	return ItemResponse{}, fmt.Errorf("Data retrieved was not 'ItemResponse'")
+}
+
+// CreateItem will create the item in request, Name and Rate are required
+// https://www.zoho.com/books/api/v3/items/#create-an-item
+func (c *Client) CreateItem(request ItemRequest) (data ItemResponse, err error) {
+	endpoint := c.NewEndpoint(ItemsModule, zoho.HTTPPost, c.URL(ItemsModule), &ItemResponse{}, request)
+
+	if err = c.Send(&endpoint, "Failed to create item"); err != nil {
+		return ItemResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ItemResponse); ok {
+		return *v, nil
+	}
+
+	return ItemResponse{}, fmt.Errorf("Data retrieved was not 'ItemResponse'")
+}
+
+// UpdateItem will update the item specified by id with request
+// https://www.zoho.com/books/api/v3/items/#update-an-item
+func (c *Client) UpdateItem(request ItemRequest, id string) (data ItemResponse, err error) {
+	endpoint := c.NewEndpoint(ItemsModule, zoho.HTTPPut, c.URL("%s/%s", ItemsModule, id), &ItemResponse{}, request)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to update item (%s)", id)); err != nil {
+		return ItemResponse{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*ItemResponse); ok {
+		return *v, nil
+	}
+
+	return ItemResponse{}, fmt.Errorf("Data retrieved was not 'ItemResponse'")
+}
+
+// DeleteItem will delete the item specified by id, items used in transactions cannot be deleted
+// https://www.zoho.com/books/api/v3/items/#delete-an-item
+func (c *Client) DeleteItem(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ItemsModule, zoho.HTTPDelete, c.URL("%s/%s", ItemsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to delete item (%s)", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkItemActive will mark the item specified by id as active
+// https://www.zoho.com/books/api/v3/items/#mark-as-active
+func (c *Client) MarkItemActive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ItemsModule, zoho.HTTPPost, c.URL("%s/%s/active", ItemsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark item (%s) as active", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// MarkItemInactive will mark the item specified by id as inactive
+// https://www.zoho.com/books/api/v3/items/#mark-as-inactive
+func (c *Client) MarkItemInactive(id string) (data Response, err error) {
+	endpoint := c.NewEndpoint(ItemsModule, zoho.HTTPPost, c.URL("%s/%s/inactive", ItemsModule, id), &Response{}, nil)
+
+	if err = c.Send(&endpoint, fmt.Sprintf("Failed to mark item (%s) as inactive", id)); err != nil {
+		return Response{}, err
+	}
+
+	if v, ok := endpoint.ResponseData.(*Response); ok {
+		return *v, nil
+	}
+
+	return Response{}, fmt.Errorf("Data retrieved was not 'Response'")
+}
+
+// ItemRequest is the data provided to CreateItem and UpdateItem
+type ItemRequest struct {
+	Name        string  `json:"name,omitempty"`
+	Rate        float64 `json:"rate,omitempty"`
+	Description string  `json:"description,omitempty"`
+	SKU         string  `json:"sku,omitempty"`
+	Unit        string  `json:"unit,omitempty"`
+	// ProductType is "goods" or "service"
+	ProductType string `json:"product_type,omitempty"`
+	// ItemType is "sales", "purchases", "sales_and_purchases" or "inventory"
+	ItemType            string        `json:"item_type,omitempty"`
+	TaxID               string        `json:"tax_id,omitempty"`
+	IsTaxable           *bool         `json:"is_taxable,omitempty"`
+	TaxExemptionID      string        `json:"tax_exemption_id,omitempty"`
+	AccountID           string        `json:"account_id,omitempty"`
+	PurchaseDescription string        `json:"purchase_description,omitempty"`
+	PurchaseRate        float64       `json:"purchase_rate,omitempty"`
+	PurchaseAccountID   string        `json:"purchase_account_id,omitempty"`
+	InventoryAccountID  string        `json:"inventory_account_id,omitempty"`
+	VendorID            string        `json:"vendor_id,omitempty"`
+	ReorderLevel        float64       `json:"reorder_level,omitempty"`
+	HSNOrSAC            string        `json:"hsn_or_sac,omitempty"`
+	CustomFields        []CustomField `json:"custom_fields,omitempty"`
+}
+
+// Item is a good or service sold or purchased by the organization
+type Item struct {
+	ItemID              string        `json:"item_id,omitempty"`
+	Name                string        `json:"name,omitempty"`
+	Status              string        `json:"status,omitempty"`
+	Description         string        `json:"description,omitempty"`
+	Rate                float64       `json:"rate,omitempty"`
+	Unit                string        `json:"unit,omitempty"`
+	SKU                 string        `json:"sku,omitempty"`
+	ProductType         string        `json:"product_type,omitempty"`
+	ItemType            string        `json:"item_type,omitempty"`
+	TaxID               string        `json:"tax_id,omitempty"`
+	TaxName             string        `json:"tax_name,omitempty"`
+	TaxPercentage       float64       `json:"tax_percentage,omitempty"`
+	IsTaxable           bool          `json:"is_taxable,omitempty"`
+	TaxExemptionID      string        `json:"tax_exemption_id,omitempty"`
+	AccountID           string        `json:"account_id,omitempty"`
+	AccountName         string        `json:"account_name,omitempty"`
+	PurchaseDescription string        `json:"purchase_description,omitempty"`
+	PurchaseRate        float64       `json:"purchase_rate,omitempty"`
+	PurchaseAccountID   string        `json:"purchase_account_id,omitempty"`
+	PurchaseAccountName string        `json:"purchase_account_name,omitempty"`
+	InventoryAccountID  string        `json:"inventory_account_id,omitempty"`
+	VendorID            string        `json:"vendor_id,omitempty"`
+	VendorName          string        `json:"vendor_name,omitempty"`
+	ReorderLevel        float64       `json:"reorder_level,omitempty"`
+	StockOnHand         float64       `json:"stock_on_hand,omitempty"`
+	HSNOrSAC            string        `json:"hsn_or_sac,omitempty"`
+	CustomFields        []CustomField `json:"custom_fields,omitempty"`
+	CreatedTime         string        `json:"created_time,omitempty"`
+	LastModifiedTime    string        `json:"last_modified_time,omitempty"`
+}
+
+// ItemsResponse is the data returned by ListItems
+type ItemsResponse struct {
+	Response
+	Items       []Item      `json:"items,omitempty"`
+	PageContext PageContext `json:"page_context,omitempty"`
+}
+
+// ItemResponse is the data returned by GetItem, CreateItem and UpdateItem
+type ItemResponse struct {
+	Response
+	Item Item `json:"item,omitempty"`
+}
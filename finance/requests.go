@@ -0,0 +1,243 @@
+package finance
+
+import (
+	"fmt"
+	"math"
+)
+
+// ItemLine returns a line item billing quantity of the item itemID, the rate and name of the item are used
+func ItemLine(itemID string, quantity float64) LineItem {
+	return LineItem{ItemID: itemID, Quantity: quantity}
+}
+
+// CustomLine returns a line item which is not an item of the organization, billing quantity at rate
+func CustomLine(name string, rate float64, quantity float64) LineItem {
+	return LineItem{Name: name, Rate: rate, Quantity: quantity}
+}
+
+// WithRate returns the line item with its rate overridden
+func (l LineItem) WithRate(rate float64) LineItem {
+	l.Rate = rate
+	return l
+}
+
+// WithDescription returns the line item with description
+func (l LineItem) WithDescription(description string) LineItem {
+	l.Description = description
+	return l
+}
+
+// WithDiscount returns the line item with discount, a percentage or an amount depending on the discount type of the invoice
+func (l LineItem) WithDiscount(discount float64) LineItem {
+	l.Discount = discount
+	return l
+}
+
+// WithTax returns the line item with the tax (or tax group) taxID applied
+func (l LineItem) WithTax(taxID string) LineItem {
+	l.TaxID = taxID
+	return l
+}
+
+// WithCustomField returns the line item with the custom field label set to value
+func (l LineItem) WithCustomField(label string, value interface{}) LineItem {
+	l.CustomFields = SetCustomField(l.CustomFields, NewCustomField(label, value))
+	return l
+}
+
+// NewCustomField returns the value of the custom field label
+func NewCustomField(label string, value interface{}) CustomField {
+	return CustomField{Label: label, Value: value}
+}
+
+// CustomFieldByID returns the value of the custom field customFieldID
+func CustomFieldByID(customFieldID string, value interface{}) CustomField {
+	return CustomField{CustomFieldID: customFieldID, Value: value}
+}
+
+// SetCustomField returns fields with field set, replacing the value of the field with the same id or label
+func SetCustomField(fields []CustomField, field CustomField) []CustomField {
+	for i, f := range fields {
+		if (field.CustomFieldID != "" && f.CustomFieldID == field.CustomFieldID) ||
+			(field.CustomFieldID == "" && field.Label != "" && f.Label == field.Label) {
+			fields[i] = field
+			return fields
+		}
+	}
+	return append(fields, field)
+}
+
+// NewAddress returns an address, the optional parts are set with the With methods
+func NewAddress(address string, city string, zip string, country string) Address {
+	return Address{Address: address, City: city, Zip: zip, Country: country}
+}
+
+// WithAttention returns the address with the name of the person it is for
+func (a Address) WithAttention(attention string) Address {
+	a.Attention = attention
+	return a
+}
+
+// WithStreet2 returns the address with a second street line
+func (a Address) WithStreet2(street2 string) Address {
+	a.Street2 = street2
+	return a
+}
+
+// WithState returns the address with its state, stateCode is required for the GST of India
+func (a Address) WithState(state string, stateCode string) Address {
+	a.State = state
+	a.StateCode = stateCode
+	return a
+}
+
+// AddLineItem adds items to the line items of the invoice
+func (r *InvoiceRequest) AddLineItem(items ...LineItem) *InvoiceRequest {
+	r.LineItems = append(r.LineItems, items...)
+	return r
+}
+
+// SetCustomField sets the custom field label of the invoice to value
+func (r *InvoiceRequest) SetCustomField(label string, value interface{}) *InvoiceRequest {
+	r.CustomFields = SetCustomField(r.CustomFields, NewCustomField(label, value))
+	return r
+}
+
+// Validate checks the fields required to create or update an invoice: the customer and at least 1 line item,
+// an update replaces every line item of the invoice
+func (r InvoiceRequest) Validate() error {
+	if r.CustomerID == "" {
+		return fmt.Errorf("a customer id is required")
+	}
+	if err := ValidateLineItems(r.LineItems); err != nil {
+		return err
+	}
+	return ValidateCustomFields(r.CustomFields)
+}
+
+// SetCustomField sets the custom field label of the contact to value
+func (r *ContactRequest) SetCustomField(label string, value interface{}) *ContactRequest {
+	r.CustomFields = SetCustomField(r.CustomFields, NewCustomField(label, value))
+	return r
+}
+
+// SetAddresses sets the billing address of the contact, and its shipping address when provided.
+// When no shipping address is provided the billing address is used.
+func (r *ContactRequest) SetAddresses(billing Address, shipping ...Address) *ContactRequest {
+	r.BillingAddress = &billing
+	r.ShippingAddress = &billing
+	if len(shipping) > 0 {
+		r.ShippingAddress = &shipping[0]
+	}
+	return r
+}
+
+// AddContactPerson adds persons to the contact persons of the contact
+func (r *ContactRequest) AddContactPerson(persons ...ContactPerson) *ContactRequest {
+	r.ContactPersons = append(r.ContactPersons, persons...)
+	return r
+}
+
+// Validate checks the custom fields of the contact, the contact name is also required by CreateContact
+func (r ContactRequest) Validate() error {
+	return ValidateCustomFields(r.CustomFields)
+}
+
+// Validate checks the fields required to create a contact person: its contact and a first name or an email,
+// the email is required when the portal is enabled
+func (p ContactPerson) Validate() error {
+	if p.ContactID == "" {
+		return fmt.Errorf("a contact id is required")
+	}
+	if p.FirstName == "" && p.Email == "" {
+		return fmt.Errorf("a first name or an email is required")
+	}
+	if p.EnablePortal && p.Email == "" {
+		return fmt.Errorf("the portal requires an email")
+	}
+	return nil
+}
+
+// ApplyToInvoice applies amount of the payment to the invoice invoiceID
+func (r *CustomerPaymentRequest) ApplyToInvoice(invoiceID string, amount float64) *CustomerPaymentRequest {
+	r.Invoices = append(r.Invoices, AppliedInvoice{InvoiceID: invoiceID, AmountApplied: amount})
+	return r
+}
+
+// SetCustomField sets the custom field label of the payment to value
+func (r *CustomerPaymentRequest) SetCustomField(label string, value interface{}) *CustomerPaymentRequest {
+	r.CustomFields = SetCustomField(r.CustomFields, NewCustomField(label, value))
+	return r
+}
+
+// Applied returns the amount of the payment applied to invoices, rounded to the cent
+func (r CustomerPaymentRequest) Applied() float64 {
+	var applied int64
+	for _, i := range r.Invoices {
+		applied += cents(i.AmountApplied)
+	}
+	return float64(applied) / 100
+}
+
+// Validate checks the fields required to create a payment: the customer, the payment mode, a positive amount and the date.
+// Every allocation must be to an invoice with a positive amount, and the allocations cannot exceed the amount of the payment.
+func (r CustomerPaymentRequest) Validate() error {
+	if r.CustomerID == "" {
+		return fmt.Errorf("a customer id is required")
+	}
+	if r.PaymentMode == "" {
+		return fmt.Errorf("a payment mode is required")
+	}
+	if cents(r.Amount) <= 0 {
+		return fmt.Errorf("the amount must be positive, %v provided", r.Amount)
+	}
+	if r.Date == "" {
+		return fmt.Errorf("a date is required")
+	}
+	for i, inv := range r.Invoices {
+		if inv.InvoiceID == "" {
+			return fmt.Errorf("allocation %d has no invoice id", i+1)
+		}
+		if cents(inv.AmountApplied) <= 0 {
+			return fmt.Errorf("allocation %d must have a positive amount, %v provided", i+1, inv.AmountApplied)
+		}
+	}
+	if applied := r.Applied(); cents(applied) > cents(r.Amount) {
+		return fmt.Errorf("the allocations (%v) exceed the amount of the payment (%v)", applied, r.Amount)
+	}
+	return ValidateCustomFields(r.CustomFields)
+}
+
+// ValidateLineItems checks that there is at least 1 line item and that every line item is an item or has a name
+func ValidateLineItems(items []LineItem) error {
+	if len(items) == 0 {
+		return fmt.Errorf("at least 1 line item is required")
+	}
+	for i, l := range items {
+		if l.ItemID == "" && l.Name == "" {
+			return fmt.Errorf("line item %d has neither an item id nor a name", i+1)
+		}
+		if l.Quantity < 0 {
+			return fmt.Errorf("line item %d has a negative quantity, %v provided", i+1, l.Quantity)
+		}
+		if err := ValidateCustomFields(l.CustomFields); err != nil {
+			return fmt.Errorf("line item %d: %s", i+1, err)
+		}
+	}
+	return nil
+}
+
+// ValidateCustomFields checks that every custom field is identified by an id or a label
+func ValidateCustomFields(fields []CustomField) error {
+	for i, f := range fields {
+		if f.CustomFieldID == "" && f.Label == "" && f.APIName == "" {
+			return fmt.Errorf("custom field %d has neither an id nor a label", i+1)
+		}
+	}
+	return nil
+}
+
+// cents returns amount in cents, amounts are compared in cents to avoid floating point errors
+func cents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
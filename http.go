@@ -29,6 +29,19 @@ type Endpoint struct {
 	BodyFormat     BodyFormat
 	Attachment     string
 	AttachmentByte []byte
+	// AttachmentField is the multipart form field the file is sent in, defaults to "attachment"
+	AttachmentField string
+	// AttachmentJSONString sends RequestBody in the JSONString form field along with the file of the
+	// FILE_BYTE body format, for the endpoints which accept both. Otherwise only the file is sent.
+	AttachmentJSONString bool
+	// AttachmentReader provides the file contents for the FILE_READER body format,
+	// Attachment is then only used as the file name
+	AttachmentReader io.Reader
+	// FormFields are the fields sent by the FORM body format
+	FormFields map[string]string
+	// PartialSuccess disables the search for errors hidden in the response body, for endpoints
+	// which report the status of every record and may succeed for some records only
+	PartialSuccess bool
 }
 
 // Parameter is used to provide URL Parameters to zoho endpoints
@@ -41,6 +54,8 @@ const (
 	FILE        = "file"
 	FILE_BYTE   = "file_byte"
 	URL         = "url" // Added new BodyFormat option
+	FILE_READER = "file_reader"
+	FORM        = "form"
 )
 
 // HTTPRequest is the function which actually performs the request to a Zoho endpoint as specified by the provided endpoint
@@ -77,7 +92,7 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 	if endpoint.BodyFormat == JSON || endpoint.BodyFormat == JSON_STRING {
 		if endpoint.RequestBody != nil {
 			// JSON Marshal the body
-			marshalledBody, err := json.Marshal(endpoint.RequestBody)
+			marshalledBody, err := Marshal(endpoint.RequestBody)
 			if err != nil {
 				return fmt.Errorf("Failed to create json from request body")
 			}
@@ -87,15 +102,21 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 		}
 	}
 
-	if endpoint.BodyFormat == JSON_STRING || endpoint.BodyFormat == FILE || endpoint.BodyFormat == FILE_BYTE {
+	if endpoint.BodyFormat == JSON_STRING || endpoint.BodyFormat == FILE || endpoint.BodyFormat == FILE_BYTE ||
+		endpoint.BodyFormat == FILE_READER || endpoint.BodyFormat == FORM {
 		// Create a multipart form
 		var b bytes.Buffer
 		w := multipart.NewWriter(&b)
 
+		fieldName := endpoint.AttachmentField
+		if fieldName == "" {
+			fieldName = "attachment"
+		}
+
 		switch endpoint.BodyFormat {
 		case FILE_BYTE:
 			// Create the correct form field
-			part, err := w.CreateFormFile("attachment", filepath.Base(endpoint.Attachment))
+			part, err := w.CreateFormFile(fieldName, filepath.Base(endpoint.Attachment))
 			if err != nil {
 				return err
 			}
@@ -103,6 +124,16 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 			if _, err = part.Write(endpoint.AttachmentByte); err != nil {
 				return err
 			}
+			// A request body is sent along with the file in the JSONString field
+			if endpoint.AttachmentJSONString && endpoint.RequestBody != nil {
+				marshalledBody, err := Marshal(endpoint.RequestBody)
+				if err != nil {
+					return fmt.Errorf("Failed to create json from request body")
+				}
+				if err = w.WriteField("JSONString", string(marshalledBody)); err != nil {
+					return err
+				}
+			}
 			err = w.Close()
 			if err != nil {
 				return err
@@ -124,6 +155,35 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 				return err
 			}
 
+		case FILE_READER:
+			if endpoint.AttachmentReader == nil {
+				return fmt.Errorf("Failed to create a request for %s: no attachment reader provided", endpoint.Name)
+			}
+			// Create the correct form field
+			part, err := w.CreateFormFile(fieldName, filepath.Base(endpoint.Attachment))
+			if err != nil {
+				return err
+			}
+			// copy the reader contents to the form
+			if _, err = io.Copy(part, endpoint.AttachmentReader); err != nil {
+				return err
+			}
+			err = w.Close()
+			if err != nil {
+				return err
+			}
+
+		case FORM:
+			for k, v := range endpoint.FormFields {
+				if err := w.WriteField(k, v); err != nil {
+					return err
+				}
+			}
+			err = w.Close()
+			if err != nil {
+				return err
+			}
+
 		case FILE:
 			// Retreive the file contents
 			fileReader, err := os.Open(endpoint.Attachment)
@@ -132,7 +192,7 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 			}
 			defer fileReader.Close()
 			// Create the correct form field
-			part, err := w.CreateFormFile("attachment", filepath.Base(endpoint.Attachment))
+			part, err := w.CreateFormFile(fieldName, filepath.Base(endpoint.Attachment))
 			if err != nil {
 				return err
 			}
@@ -199,7 +259,7 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 		}
 
 		// Search for hidden errors (appears on success response)
-		if bytes.Contains(body, []byte(`"status":"error"`)) {
+		if !endpoint.PartialSuccess && bytes.Contains(body, []byte(`"status":"error"`)) {
 			return fmt.Errorf("%s", string(body))
 		}
 	}
@@ -274,3 +334,60 @@ const (
 	// HTTPDelete is the DELETE method for http requests
 	HTTPDelete HTTPMethod = "DELETE"
 )
+
+// HTTPDownload performs the request specified by endpoint and copies the raw response body into w.
+// It is used for endpoints which return files (zip archives, attachments, images) rather than JSON,
+// the ResponseData field of the endpoint is ignored.
+func (z *Zoho) HTTPDownload(endpoint *Endpoint, w io.Writer) (err error) {
+	// Load and renew access token if expired
+	err = z.CheckForSavedTokens()
+	if err == ErrTokenExpired {
+		err := z.RefreshTokenRequest()
+		if err != nil {
+			return fmt.Errorf("Failed to refresh the access token: %s: %s", endpoint.Name, err)
+		}
+	}
+
+	q := url.Values{}
+	for k, v := range endpoint.URLParameters {
+		if v != "" {
+			q.Set(k, string(v))
+		}
+	}
+
+	method := endpoint.Method
+	if method == "" {
+		method = HTTPGet
+	}
+
+	req, err := http.NewRequest(string(method), fmt.Sprintf("%s?%s", endpoint.URL, q.Encode()), nil)
+	if err != nil {
+		return fmt.Errorf("Failed to create a request for %s: %s", endpoint.Name, err)
+	}
+
+	// Add global authorization header
+	req.Header.Add("Authorization", "Zoho-oauthtoken "+z.oauth.token.AccessToken)
+
+	// Add specific endpoint headers
+	for k, v := range endpoint.Headers {
+		req.Header.Add(k, v)
+	}
+
+	resp, err := z.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Failed to perform request for %s: %s", endpoint.Name, err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("Failed to download %s: got status %d %s: %s", endpoint.Name, resp.StatusCode, resolveStatus(resp), string(body))
+	}
+
+	if _, err = io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("Failed to read body of response for %s: %s", endpoint.Name, err)
+	}
+
+	return nil
+}
@@ -9,9 +9,11 @@ import (
 	zoho "github.com/iapon/zoho"
 )
 
-func (c *API) AttachInvoiceFile(request interface{}, invoiceId string, file []byte, filename string) (data EmailInvoiceResponse, err error) {
+// AttachInvoiceFile attaches file to the invoice invoiceId, the attachment is sent along with the invoice emails
+// https://www.zoho.com/invoice/api/v3/#Invoices_Add_attachment_to_an_invoice
+func (c *API) AttachInvoiceFile(invoiceId string, file []byte, filename string) (data EmailInvoiceResponse, err error) {
 	endpoint := zoho.Endpoint{
-		URL:          fmt.Sprintf("%s%s/%s/attachment", InvoiceAPIEndpoint, InvoicesModule, invoiceId),
+		URL:          c.url("%s/%s/attachment", InvoicesModule, invoiceId),
 		Method:       zoho.HTTPPost,
 		ResponseData: &EmailInvoiceResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -19,39 +21,39 @@ func (c *API) AttachInvoiceFile(request interface{}, invoiceId string, file []by
 			"filter_by":        "",
 			"send_attachment":  zoho.Parameter("true"),
 		},
-		RequestBody:    &request,
 		BodyFormat:     zoho.FILE_BYTE,
 		AttachmentByte: file,
 		Attachment:     filename,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
-		return EmailInvoiceResponse{}, fmt.Errorf("failed to update invoice: %s", err)
+		return EmailInvoiceResponse{}, fmt.Errorf("Failed to attach file to invoice: %s", err)
 	}
 
 	if v, ok := endpoint.ResponseData.(*EmailInvoiceResponse); ok {
 		// Check if the request succeeded
 		if v.Code != 0 {
-			return *v, fmt.Errorf("failed to update invoice: %s", v.Message)
+			return *v, fmt.Errorf("Failed to attach file to invoice: %s", v.Message)
 		}
 		return *v, nil
 	}
-	return EmailInvoiceResponse{}, fmt.Errorf("data retrieved was not 'EmailInvoiceResponse'")
+	return EmailInvoiceResponse{}, fmt.Errorf("Data retrieved was not 'EmailInvoiceResponse'")
 }
-func (c *API) DeleteInvoiceFile(request interface{}, invoiceId string) (data DeleteAttachmentResponse, err error) {
+
+// DeleteInvoiceFile deletes the file attached to the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_Delete_an_attachment
+func (c *API) DeleteInvoiceFile(invoiceId string) (data DeleteAttachmentResponse, err error) {
 	endpoint := zoho.Endpoint{
-		URL:    fmt.Sprintf("%s%s/%s/attachment", InvoiceAPIEndpoint, InvoicesModule, invoiceId),
+		URL:    c.url("%s/%s/attachment", InvoicesModule, invoiceId),
 		Method: zoho.HTTPDelete,
 		URLParameters: map[string]zoho.Parameter{
 			"filter_by": "",
 		},
-		RequestBody: &request,
-		BodyFormat:  zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 		ResponseData: &DeleteAttachmentResponse{},
 	}
@@ -70,6 +72,7 @@ func (c *API) DeleteInvoiceFile(request interface{}, invoiceId string) (data Del
 	}
 	return DeleteAttachmentResponse{}, fmt.Errorf("Data retrieved was not 'DeleteAttachmentResponse'")
 }
+
 func (c *API) GetAttachment(invoiceId string) ([]byte, error) {
 	err := c.CheckForSavedTokens()
 	if err == zoho.ErrTokenExpired {
@@ -79,7 +82,7 @@ func (c *API) GetAttachment(invoiceId string) ([]byte, error) {
 		}
 	}
 	client := &http.Client{}
-	endpointURL := fmt.Sprintf("%s%s/%s/attachment", InvoiceAPIEndpoint, InvoicesModule, invoiceId)
+	endpointURL := c.url("%s/%s/attachment", InvoicesModule, invoiceId)
 	q := url.Values{}
 	q.Set("organization_id", c.OrganizationID)
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s?%s", endpointURL, q.Encode()), nil)
@@ -89,7 +92,7 @@ func (c *API) GetAttachment(invoiceId string) ([]byte, error) {
 
 	// Add global authorization header
 	req.Header.Add("Authorization", "Zoho-oauthtoken "+c.GetOauthToken())
-	req.Header.Add(InvoiceAPIEndpointHeader, c.OrganizationID)
+	req.Header.Add(c.organizationHeader(), c.OrganizationID)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to perform request for %s: %s", InvoicesModule, err)
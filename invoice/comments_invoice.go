@@ -0,0 +1,33 @@
+package invoice
+
+import "fmt"
+
+// ListInvoiceComments returns the comments and the history of the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_List_invoice_comments_&_history
+func (c *API) ListInvoiceComments(invoiceId string) (data InvoiceCommentsResponse, err error) {
+	return c.Finance().ListInvoiceComments(invoiceId)
+}
+
+// AddInvoiceComment adds the comment in request to the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_Add_comment
+func (c *API) AddInvoiceComment(request CommentRequest, invoiceId string) (data InvoiceCommentResponse, err error) {
+	if request.Description == "" {
+		return InvoiceCommentResponse{}, fmt.Errorf("Failed to add comment to invoice (%s): a description is required", invoiceId)
+	}
+	return c.Finance().AddInvoiceComment(request, invoiceId)
+}
+
+// UpdateInvoiceComment updates the comment commentId of the invoice invoiceId with request
+// https://www.zoho.com/invoice/api/v3/#Invoices_Update_comment
+func (c *API) UpdateInvoiceComment(request CommentRequest, invoiceId string, commentId string) (data InvoiceCommentResponse, err error) {
+	if request.Description == "" {
+		return InvoiceCommentResponse{}, fmt.Errorf("Failed to update comment (%s) of invoice (%s): a description is required", commentId, invoiceId)
+	}
+	return c.Finance().UpdateInvoiceComment(request, invoiceId, commentId)
+}
+
+// DeleteInvoiceComment deletes the comment commentId of the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_Delete_a_comment
+func (c *API) DeleteInvoiceComment(invoiceId string, commentId string) (data Response, err error) {
+	return c.Finance().DeleteInvoiceComment(invoiceId, commentId)
+}
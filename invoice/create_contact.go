@@ -10,11 +10,20 @@ import (
 
 // https://www.zoho.com/invoice/api/v3/#Contacts_Create_a_Contact
 // func (c *API) CreateContact(request interface{}, OrganizationID string, params map[string]zoho.Parameter) (data ListContactsResponse, err error) {
-func (c *API) CreateContact(request interface{}, enablePortal bool) (data CreateContactResponse, err error) {
+func (c *API) CreateContact(request CreateContactRequest, enablePortal bool) (data CreateContactResponse, err error) {
+	if request.ContactName == "" {
+		return CreateContactResponse{}, fmt.Errorf("Failed to create contact: a contact name is required")
+	}
+	if err = request.Validate(); err != nil {
+		return CreateContactResponse{}, fmt.Errorf("Failed to create contact: %s", err)
+	}
+	if enablePortal && (len(request.ContactPersons) == 0 || request.ContactPersons[0].Email == "") {
+		return CreateContactResponse{}, fmt.Errorf("Failed to create contact: the portal requires a first contact person with an email")
+	}
 
 	endpoint := zoho.Endpoint{
 		Name:         ContactsModule,
-		URL:          fmt.Sprintf(InvoiceAPIEndpoint+"%s", ContactsModule),
+		URL:          c.url("%s", ContactsModule),
 		Method:       zoho.HTTPPost,
 		ResponseData: &CreateContactResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -23,7 +32,7 @@ func (c *API) CreateContact(request interface{}, enablePortal bool) (data Create
 		RequestBody: &request,
 		BodyFormat:  zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -45,7 +54,7 @@ func (c *API) CreateContact(request interface{}, enablePortal bool) (data Create
 		if enablePortal {
 			endpoint := zoho.Endpoint{
 				Name:         ContactsModule,
-				URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s/%s/portal/enable", c.ZohoTLD, ContactsModule, v.Contact.ContactID),
+				URL:          c.url("%s/%s/portal/enable", ContactsModule, v.Contact.ContactID),
 				Method:       zoho.HTTPPost,
 				ResponseData: &EnableContactDashboardResponse{},
 				URLParameters: map[string]zoho.Parameter{
@@ -57,7 +66,7 @@ func (c *API) CreateContact(request interface{}, enablePortal bool) (data Create
 					}}},
 				BodyFormat: zoho.JSON_STRING,
 				Headers: map[string]string{
-					InvoiceAPIEndpointHeader: c.OrganizationID,
+					c.organizationHeader(): c.OrganizationID,
 				},
 			}
 			err = c.Zoho.HTTPRequest(&endpoint)
@@ -83,31 +92,6 @@ type EnableContactDashboardResponse struct {
 	Message string `json:"message"`
 }
 
-type CreateContactRequest struct {
-	ContactName      string                  `json:"contact_name"`
-	CompanyName      string                  `json:"company_name"`
-	CustomerSubType  string                  `json:"customer_sub_type,omitempty"`
-	PaymentTerms     int64                   `json:"payment_terms,omitempty"`
-	CurrencyID       string                  `json:"currency_id,omitempty"`
-	Website          string                  `json:"website,omitempty"`
-	CustomFields     []CustomFieldRequest    `json:"custom_fields,omitempty"`
-	BillingAddress   ContactAddress          `json:"billing_address"`
-	ShippingAddress  ContactAddress          `json:"shipping_address"`
-	ContactPersons   []ContactPerson         `json:"contact_persons"`
-	DefaultTemplates ContactDefaultTemplates `json:"default_templates,omitempty"`
-	LanguageCode     string                  `json:"language_code"`
-	Notes            string                  `json:"notes,omitempty"`
-	PlaceOfContact   string                  `json:"place_of_contact,omitempty"`
-	GSTNo            string                  `json:"gst_no,omitempty"`
-	GSTTreatment     string                  `json:"gst_treatment,omitempty"`
-	TaxExemptionID   string                  `json:"tax_exemption_id,omitempty"`
-	TaxAuthorityID   string                  `json:"tax_authority_id,omitempty"`
-	TaxID            string                  `json:"tax_id,omitempty"`
-	IsTaxable        string                  `json:"is_taxable,omitempty"`
-	Facebook         string                  `json:"facebook,omitempty"`
-	Twitter          string                  `json:"twitter,omitempty"`
-}
-
 type CreateContactResponse struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
@@ -157,46 +141,3 @@ type CreateContactResponse struct {
 	} `json:"contact"`
 }
 
-type ContactPerson struct {
-	ContactID        string `json:"contact_id,omitempty"`
-	ContactPersonID  string `json:"contact_person_id,omitempty"`
-	Salutation       string `json:"salutation,omitempty"`
-	FirstName        string `json:"first_name,omitempty"`
-	LastName         string `json:"last_name,omitempty"`
-	Email            string `json:"email,omitempty"`
-	Phone            string `json:"phone,omitempty"`
-	Mobile           string `json:"mobile,omitempty"`
-	IsPrimaryContact bool   `json:"is_primary_contact,omitempty"`
-	Skype            string `json:"skype,omitempty"`
-	Designation      string `json:"designation,omitempty"`
-	Department       string `json:"department,omitempty"`
-	IsAddedInPortal  *bool  `json:"is_added_in_portal"`
-}
-
-type ContactAddress struct {
-	Attention string `json:"attention,omitempty"`
-	Address   string `json:"address,omitempty"`
-	Street2   string `json:"street2,omitempty"`
-	StateCode string `json:"state_code,omitempty"`
-	City      string `json:"city,omitempty"`
-	State     string `json:"state,omitempty"`
-	Zip       string `json:"zip,omitempty"`
-	Country   string `json:"country,omitempty"`
-	Fax       string `json:"fax,omitempty"`
-	Phone     string `json:"phone,omitempty"`
-}
-
-type ContactDefaultTemplates struct {
-	InvoiceTemplateID           string `json:"invoice_template_id,omitempty"`
-	InvoiceTemplateName         string `json:"invoice_template_name,omitempty"`
-	EstimateTemplateID          string `json:"estimate_template_id,omitempty"`
-	EstimateTemplateName        string `json:"estimate_template_name,omitempty"`
-	CreditnoteTemplateID        string `json:"creditnote_template_id,omitempty"`
-	CreditnoteTemplateName      string `json:"creditnote_template_name,omitempty"`
-	InvoiceEmailTemplateID      string `json:"invoice_email_template_id,omitempty"`
-	InvoiceEmailTemplateName    string `json:"invoice_email_template_name,omitempty"`
-	EstimateEmailTemplateID     string `json:"estimate_email_template_id,omitempty"`
-	EstimateEmailTemplateName   string `json:"estimate_email_template_name,omitempty"`
-	CreditnoteEmailTemplateID   string `json:"creditnote_email_template_id,omitempty"`
-	CreditnoteEmailTemplateName string `json:"creditnote_email_template_name,omitempty"`
-}
@@ -8,20 +8,23 @@ import (
 
 // https://www.zoho.com/invoice/api/v3/#Contact_Persons_Create_a_contact_person
 // func (c *API) CreateContactPerson(request interface{}, OrganizationID string, params map[string]zoho.Parameter) (data CreateContactPersonResponse, err error) {
-func (c *API) CreateContactPerson(request interface{}) (data CreateContactPersonResponse, err error) {
+func (c *API) CreateContactPerson(request CreateContactPersonRequest) (data CreateContactPersonResponse, err error) {
+	if err = request.Validate(); err != nil {
+		return CreateContactPersonResponse{}, fmt.Errorf("Failed to create contact person: %s", err)
+	}
 
 	endpoint := zoho.Endpoint{
 		Name:         ContactsModule,
-		URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s/%s", c.ZohoTLD, ContactsModule, ContactsPersonSubModule),
+		URL:          c.url("%s/%s", ContactsModule, ContactsPersonSubModule),
 		Method:       zoho.HTTPPost,
 		ResponseData: &CreateContactPersonResponse{},
 		URLParameters: map[string]zoho.Parameter{
 			"filter_by": "",
 		},
-		RequestBody: &request,
+		RequestBody: request,
 		BodyFormat:  zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -45,21 +48,6 @@ func (c *API) CreateContactPerson(request interface{}) (data CreateContactPerson
 	return CreateContactPersonResponse{}, fmt.Errorf("Data retrieved was not 'CreateContactPersonResponse'")
 }
 
-type CreateContactPersonRequest struct {
-	ContactID    string `json:"contact_id"`
-	Salutation   string `json:"salutation,omitempty"`
-	FirstName    string `json:"first_name"`
-	LastName     string `json:"last_name"`
-	Email        string `json:"email"`
-	Phone        string `json:"phone,omitempty"`
-	Mobile       string `json:"mobile,omitempty"`
-	Skype        string `json:"skype,omitempty"`
-	Designation  string `json:"designation,omitempty"`
-	Department   string `json:"department,omitempty"`
-	EnablePortal bool   `json:"enable_portal"`
-}
-
-
 type CreateContactPersonResponse struct {
 	Code          int    `json:"code"`
 	Message       string `json:"message"`
@@ -25,27 +25,16 @@ type CreateItemResponse struct {
 	} `json:"item"`
 }
 
-type CreateItemRequest struct {
-	Name         string        `json:"name"`
-	Description  string        `json:"description"`
-	Rate         string        `json:"rate"`
-	TaxID        string        `json:"tax_id"`
-	CustomFields []interface{} `json:"custom_fields"`
-	ItemType     string        `json:"item_type"`
-	ProductType  string        `json:"product_type"`
-	Unit         string        `json:"unit"`
-}
-
 func (c *API) CreateItem(request CreateItemRequest) (data CreateItemResponse, err error) {
 	endpoint := zoho.Endpoint{
 		Name:         ItemsModule,
-		URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s", c.ZohoTLD, ItemsModule),
+		URL:          c.url("%s", ItemsModule),
 		Method:       zoho.HTTPPost,
 		ResponseData: &CreateItemResponse{},
 		RequestBody:  request,
 		BodyFormat:   zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -8,11 +8,14 @@ import (
 
 // https://www.zoho.com/invoice/api/v3/#Customer_Payments_Create_a_payment
 // func (c *API) CreatePayment(request interface{}, OrganizationID string, params map[string]zoho.Parameter) (data ListContactsResponse, err error) {
-func (c *API) CreatePayment(request interface{}) (data CreatePaymentResponse, err error) {
+func (c *API) CreatePayment(request CreatePaymentRequest) (data CreatePaymentResponse, err error) {
+	if err = request.Validate(); err != nil {
+		return CreatePaymentResponse{}, fmt.Errorf("Failed to create payment: %s", err)
+	}
 
 	endpoint := zoho.Endpoint{
-		Name:         ContactsModule,
-		URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s", c.ZohoTLD, ContactsModule),
+		Name:         CustomerPaymentsModule,
+		URL:          c.url("%s", CustomerPaymentsModule),
 		Method:       zoho.HTTPPost,
 		ResponseData: &CreatePaymentResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -21,7 +24,7 @@ func (c *API) CreatePayment(request interface{}) (data CreatePaymentResponse, er
 		RequestBody: request,
 		BodyFormat:  zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -44,27 +47,6 @@ func (c *API) CreatePayment(request interface{}) (data CreatePaymentResponse, er
 	return CreatePaymentResponse{}, fmt.Errorf("Data retrieved was not 'CreatePaymentResponse'")
 }
 
-type CreatePaymentRequest struct {
-	CustomerId      string                 `json:"customer_id"`
-	PaymentMode     string                 `json:"payment_mode"`
-	Amount          float64                `json:"amount"`
-	Date            string                 `json:"date"`
-	ReferenceNumber string                 `json:"reference_number"`
-	Description     string                 `json:"description"`
-	Invoices        []CreatePaymentInvoice `json:"invoices"`
-	ExchangeRate    float64                `json:"exchange_rate"`
-	BankCharges     float64                `json:"bank_charges"`
-	CustomFields    []struct {
-		Label string `json:"label"`
-		Value string `json:"value"`
-	}
-}
-
-type CreatePaymentInvoice struct {
-	CustomerId    string  `json:"invoice_id"`
-	AmountApplied float64 `json:"amount_applied"`
-}
-
 type CreatePaymentResponse struct {
 	Code    int64  `json:"code"`
 	Message string `json:"message"`
@@ -4,15 +4,19 @@ import (
 	"fmt"
 
 	zoho "github.com/iapon/zoho"
+	"github.com/iapon/zoho/finance"
 )
 
 // https://www.zoho.com/invoice/api/v3/#Recurring_Invoices_Create_a_Recurring_Invoice
 // func (c *API) CreateRecurringInvoice(request interface{}, OrganizationID string, params map[string]zoho.Parameter) (data ListContactsResponse, err error) {
-func (c *API) CreateRecurringInvoice(request interface{}) (data CreateRecurringInvoiceResponse, err error) {
+func (c *API) CreateRecurringInvoice(request CreateRecurringInvoiceRequest) (data CreateRecurringInvoiceResponse, err error) {
+	if err = request.Validate(); err != nil {
+		return CreateRecurringInvoiceResponse{}, fmt.Errorf("Failed to create recurring invoice: %s", err)
+	}
 
 	endpoint := zoho.Endpoint{
 		Name:         RecurringInvoicesModule,
-		URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s", c.ZohoTLD, RecurringInvoicesModule),
+		URL:          c.url("%s", RecurringInvoicesModule),
 		Method:       zoho.HTTPPost,
 		ResponseData: &CreateRecurringInvoiceResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -21,7 +25,7 @@ func (c *API) CreateRecurringInvoice(request interface{}) (data CreateRecurringI
 		RequestBody: request,
 		BodyFormat:  zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -70,6 +74,41 @@ type CreateRecurringInvoiceRequest struct {
 	TaxExemptionId      string               `json:"tax_exemption_id,omitempty"`
 }
 
+// AddLineItem adds items to the line items of the recurring invoice
+func (r *CreateRecurringInvoiceRequest) AddLineItem(items ...InvoiceLineItem) *CreateRecurringInvoiceRequest {
+	r.LineItems = append(r.LineItems, items...)
+	return r
+}
+
+// SetCustomField sets the custom field label of the recurring invoice to value
+func (r *CreateRecurringInvoiceRequest) SetCustomField(label string, value interface{}) *CreateRecurringInvoiceRequest {
+	r.CustomFields = finance.SetCustomField(r.CustomFields, NewCustomField(label, value))
+	return r
+}
+
+// Validate checks the fields required to create a recurring invoice: its name, the customer, the start date,
+// a recurrence frequency of days, weeks, months or years and at least 1 line item
+func (r CreateRecurringInvoiceRequest) Validate() error {
+	if r.RecurrenceName == "" {
+		return fmt.Errorf("a recurrence name is required")
+	}
+	if r.CustomerId == "" {
+		return fmt.Errorf("a customer id is required")
+	}
+	if r.StartDate == "" {
+		return fmt.Errorf("a start date is required")
+	}
+	switch r.RecurrenceFrequency {
+	case "days", "weeks", "months", "years":
+	default:
+		return fmt.Errorf("the recurrence frequency must be days, weeks, months or years, %q provided", r.RecurrenceFrequency)
+	}
+	if err := finance.ValidateLineItems(r.LineItems); err != nil {
+		return err
+	}
+	return finance.ValidateCustomFields(r.CustomFields)
+}
+
 /*
 type RecurringInvoiceLineItem struct {
 	ItemId         string  `json:"item_id"`
@@ -132,13 +171,3 @@ type CreateRecurringInvoiceResponse struct {
 	} `json:"recurring_invoice"`
 }
 
-type PaymentOptions struct {
-	PaymentGateways []PaymentGateway `json:"payment_gateways,omitempty"`
-}
-
-type PaymentGateway struct {
-	AdditionalField1     string `json:"additional_field1,omitempty"`
-	Configured           bool   `json:"configured,omitempty"`
-	GatewayName          string `json:"gateway_name,omitempty"`
-	GatewayNameFormatted string `json:"gateway_name_formatted,omitempty"`
-}
@@ -0,0 +1,25 @@
+package invoice
+
+import "fmt"
+
+// ApplyCreditsToInvoice applies the unused payments (eg. paid retainer invoices) and credit notes of request
+// to the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_Apply_credits
+func (c *API) ApplyCreditsToInvoice(request InvoiceCreditsRequest, invoiceId string) (data Response, err error) {
+	if len(request.InvoicePayments) == 0 && len(request.ApplyCreditNotes) == 0 {
+		return Response{}, fmt.Errorf("Failed to apply credits to invoice (%s): no payment or credit note provided", invoiceId)
+	}
+	return c.Finance().ApplyCreditsToInvoice(request, invoiceId)
+}
+
+// ListInvoiceCreditsApplied returns the credit notes applied to the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_List_credits_applied
+func (c *API) ListInvoiceCreditsApplied(invoiceId string) (data InvoiceCreditsAppliedResponse, err error) {
+	return c.Finance().ListInvoiceCreditsApplied(invoiceId)
+}
+
+// DeleteInvoiceCreditApplied removes the credit note creditnotesInvoiceId applied to the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_Delete_applied_credit
+func (c *API) DeleteInvoiceCreditApplied(invoiceId string, creditnotesInvoiceId string) (data Response, err error) {
+	return c.Finance().DeleteInvoiceCreditApplied(invoiceId, creditnotesInvoiceId)
+}
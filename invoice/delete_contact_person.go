@@ -11,13 +11,8 @@ import (
 func (c *API) DeleteContactPerson(contactPersonID string) (data DeleteContactPersonResponse, err error) {
 
 	endpoint := zoho.Endpoint{
-		Name: ContactsModule,
-		URL: fmt.Sprintf(
-			"https://www.zohoapis.%s/invoice/v3/%s/%s/%s", c.ZohoTLD,
-			ContactsModule,
-			ContactsPersonSubModule,
-			contactPersonID,
-		),
+		Name:         ContactsModule,
+		URL:          c.url("%s/%s/%s", ContactsModule, ContactsPersonSubModule, contactPersonID),
 		Method:       zoho.HTTPDelete,
 		ResponseData: &DeleteContactPersonResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -25,7 +20,7 @@ func (c *API) DeleteContactPerson(contactPersonID string) (data DeleteContactPer
 		},
 		BodyFormat: zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
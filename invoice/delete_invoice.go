@@ -0,0 +1,7 @@
+package invoice
+
+// DeleteInvoice deletes the invoice invoiceId, invoices with payments or credits applied cannot be deleted
+// https://www.zoho.com/invoice/api/v3/#Invoices_Delete_an_invoice
+func (c *API) DeleteInvoice(invoiceId string) (data Response, err error) {
+	return c.Finance().DeleteInvoice(invoiceId)
+}
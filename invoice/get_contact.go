@@ -11,7 +11,7 @@ import (
 func (c *API) GetContact(contactId string) (data GetContactResponse, err error) {
 	endpoint := zoho.Endpoint{
 		Name:         InvoicesModule,
-		URL:          fmt.Sprintf("%s%s/%s", InvoiceAPIEndpoint, ContactsModule, contactId),
+		URL:          c.url("%s/%s", ContactsModule, contactId),
 		Method:       zoho.HTTPGet,
 		ResponseData: &GetContactResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -19,7 +19,7 @@ func (c *API) GetContact(contactId string) (data GetContactResponse, err error)
 		},
 		BodyFormat: zoho.JSON,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -10,7 +10,7 @@ import (
 func (c *API) GetContactPerson(contactID, contactPersonID string) (data GetContactPersonResponse, err error) {
 	endpoint := zoho.Endpoint{
 		Name:         InvoicesModule,
-		URL:          fmt.Sprintf("%s%s/%s/%s/%s", InvoiceAPIEndpoint, ContactsModule, contactID, ContactsPersonSubModule, contactPersonID),
+		URL:          c.url("%s/%s/%s/%s", ContactsModule, contactID, ContactsPersonSubModule, contactPersonID),
 		Method:       zoho.HTTPGet,
 		ResponseData: &GetContactPersonResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -18,7 +18,7 @@ func (c *API) GetContactPerson(contactID, contactPersonID string) (data GetConta
 		},
 		BodyFormat: zoho.JSON,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
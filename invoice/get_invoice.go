@@ -3,7 +3,6 @@ package invoice
 import (
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
 
@@ -15,7 +14,7 @@ import (
 func (c *API) GetInvoice(invoiceId string) (data GetInvoiceResponse, err error) {
 	endpoint := zoho.Endpoint{
 		Name:         InvoicesModule,
-		URL:          fmt.Sprintf("%s%s/%s", InvoiceAPIEndpoint, InvoicesModule, invoiceId),
+		URL:          c.url("%s/%s", InvoicesModule, invoiceId),
 		Method:       zoho.HTTPGet,
 		ResponseData: &GetInvoiceResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -23,10 +22,9 @@ func (c *API) GetInvoice(invoiceId string) (data GetInvoiceResponse, err error)
 		},
 		BodyFormat: zoho.JSON,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
-	log.Println(fmt.Sprintf("%s%s/%s", InvoiceAPIEndpoint, InvoicesModule, invoiceId))
 	/*for k, v := range params {
 	  	endpoint.URLParameters[k] = v
 	  }
@@ -152,7 +150,7 @@ func (c *API) GetInvoicePDF(invoiceId string) ([]byte, error) {
 		}
 	}
 	client := &http.Client{}
-	endpointURL := fmt.Sprintf("%s%s/%s", InvoiceAPIEndpoint, InvoicesModule, invoiceId)
+	endpointURL := c.url("%s/%s", InvoicesModule, invoiceId)
 	q := url.Values{}
 	q.Set("organization_id", c.OrganizationID)
 	q.Set("accept", "pdf")
@@ -163,7 +161,7 @@ func (c *API) GetInvoicePDF(invoiceId string) ([]byte, error) {
 
 	// Add global authorization header
 	req.Header.Add("Authorization", "Zoho-oauthtoken "+c.GetOauthToken())
-	req.Header.Add(InvoiceAPIEndpointHeader, c.OrganizationID)
+	req.Header.Add(c.organizationHeader(), c.OrganizationID)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to perform request for %s: %s", InvoicesModule, err)
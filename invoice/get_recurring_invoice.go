@@ -12,7 +12,7 @@ func (c *API) GetRecurringInvoice(recurringInvoiceId string) (data RecurringInvo
 
 	endpoint := zoho.Endpoint{
 		Name:         RecurringInvoicesModule,
-		URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s/%s", c.ZohoTLD, RecurringInvoicesModule, recurringInvoiceId),
+		URL:          c.url("%s/%s", RecurringInvoicesModule, recurringInvoiceId),
 		Method:       zoho.HTTPGet,
 		ResponseData: &RecurringInvoiceResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -20,7 +20,7 @@ func (c *API) GetRecurringInvoice(recurringInvoiceId string) (data RecurringInvo
 		},
 		BodyFormat: zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
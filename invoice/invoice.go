@@ -1,9 +1,11 @@
 package invoice
 
 import (
+	"fmt"
 	"math/rand"
 
 	zoho "github.com/iapon/zoho"
+	"github.com/iapon/zoho/finance"
 )
 
 const (
@@ -16,27 +18,71 @@ const (
 	CustomerPaymentsModule   string = "customerpayments"
 )
 
+// InvoiceAPIEndpoint was the base URL of every request.
+//
+// Deprecated: it is no longer read, the endpoint is picked by the Product of the API, see NewWithProduct
 var InvoiceAPIEndpoint string = "https://www.zohoapis.com/invoice/v3/"
 
-type CustomFieldRequest struct {
-	CustomfieldID string      `json:"customfield_id,omitempty"`
-	Label         string      `json:"label"`
-	Value         interface{} `json:"value,omitempty"`
-}
+// The models of contacts, items, invoices and customer payments are shared with Zoho Books, they are defined in the
+// finance package
+type (
+	CustomFieldRequest         = finance.CustomField
+	ContactAddress             = finance.Address
+	ContactPerson              = finance.ContactPerson
+	ContactDefaultTemplates    = finance.DefaultTemplates
+	CreateContactRequest       = finance.ContactRequest
+	UpdateContactRequest       = finance.ContactRequest
+	CreateContactPersonRequest = finance.ContactPerson
+	CreateItemRequest          = finance.ItemRequest
+	CreateInvoiceRequest       = finance.InvoiceRequest
+	UpdateInvoiceRequest       = finance.InvoiceRequest
+	Invoice                    = finance.Invoice
+	InvoiceLineItem            = finance.LineItem
+	PaymentOptions             = finance.PaymentOptions
+	PaymentGateway             = finance.PaymentGateway
+	CreatePaymentRequest       = finance.CustomerPaymentRequest
+	CreatePaymentInvoice       = finance.AppliedInvoice
+)
+
+// The models of the invoice lifecycle operations are the same in Zoho Books, they are defined in the finance package
+type (
+	Response                      = finance.Response
+	InvoiceCreditsRequest         = finance.InvoiceCreditsRequest
+	AppliedPayment                = finance.AppliedPayment
+	AppliedCreditNote             = finance.AppliedCreditNote
+	InvoicePayment                = finance.InvoicePayment
+	InvoicePaymentsResponse       = finance.InvoicePaymentsResponse
+	AppliedCredit                 = finance.AppliedCredit
+	InvoiceCreditsAppliedResponse = finance.InvoiceCreditsAppliedResponse
+	CommentRequest                = finance.CommentRequest
+	Comment                       = finance.Comment
+	InvoiceCommentsResponse       = finance.InvoiceCommentsResponse
+	InvoiceCommentResponse        = finance.InvoiceCommentResponse
+)
 
 // API is used for interacting with the Zoho expense API
 // the exposed methods are primarily access to expense modules which provide access to expense Methods
 type API struct {
 	*zoho.Zoho
 	id string
+	// Product is the finance product requests are sent to, Zoho Invoice by default
+	Product finance.Product
 }
 
+// SetBooking sends the requests of this client to Zoho Books rather than Zoho Invoice
+//
+// Deprecated: use NewWithProduct(z, finance.ZohoBooks) or set Product
 func (c *API) SetBooking() {
-	InvoiceAPIEndpoint = "https://www.zohoapis.com/books/v3/"
+	c.Product = finance.ZohoBooks
 }
 
 // New returns a *invoice.API with the provided zoho.Zoho as an embedded field
 func New(z *zoho.Zoho) *API {
+	return NewWithProduct(z, finance.ZohoInvoice)
+}
+
+// NewWithProduct returns a *invoice.API sending its requests to product, every client picks its product independently
+func NewWithProduct(z *zoho.Zoho, product finance.Product) *API {
 	id := func() string {
 		var id []byte
 		keyspace := "abcdefghijklmnopqrutuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
@@ -47,8 +93,32 @@ func New(z *zoho.Zoho) *API {
 	}()
 
 	API := API{
-		Zoho: z,
-		id:   id,
+		Zoho:    z,
+		id:      id,
+		Product: product,
 	}
 	return &API
 }
+
+// Finance returns a *finance.Client for the product and organization of this client,
+// giving access to the typed operations shared by Zoho Invoice and Zoho Books
+func (c *API) Finance() *finance.Client {
+	return finance.New(c.Zoho, c.product())
+}
+
+func (c *API) product() finance.Product {
+	if c.Product == "" {
+		return finance.ZohoInvoice
+	}
+	return c.Product
+}
+
+// url returns the URL of path in the API of the product of this client
+func (c *API) url(path string, args ...interface{}) string {
+	return fmt.Sprintf(c.product().Endpoint(), c.ZohoTLD) + fmt.Sprintf(path, args...)
+}
+
+// organizationHeader returns the header holding the organization for the product of this client
+func (c *API) organizationHeader() string {
+	return c.product().OrganizationHeader()
+}
@@ -12,7 +12,7 @@ func (c *API) ListContactPersons() (data ListContactPersonsResponse, err error)
 
 	endpoint := zoho.Endpoint{
 		Name:         ContactsModule,
-		URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s/%s", c.ZohoTLD, ContactsModule, ContactsPersonSubModule),
+		URL:          c.url("%s/%s", ContactsModule, ContactsPersonSubModule),
 		Method:       zoho.HTTPGet,
 		ResponseData: &ListContactPersonsResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -20,7 +20,7 @@ func (c *API) ListContactPersons() (data ListContactPersonsResponse, err error)
 		},
 		BodyFormat: zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -12,7 +12,7 @@ func (c *API) ListContacts() (data ListContactsResponse, err error) {
 
 	endpoint := zoho.Endpoint{
 		Name:         ContactsModule,
-		URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s", c.ZohoTLD, ContactsModule),
+		URL:          c.url("%s", ContactsModule),
 		Method:       zoho.HTTPGet,
 		ResponseData: &ListContactsResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -20,7 +20,7 @@ func (c *API) ListContacts() (data ListContactsResponse, err error) {
 		},
 		BodyFormat: zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
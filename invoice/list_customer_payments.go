@@ -12,7 +12,7 @@ func (c *API) ListCustomerPayments() (data ListCustomerPaymentsResponse, err err
 
 	endpoint := zoho.Endpoint{
 		Name:          CustomerPaymentsModule,
-		URL:           fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s", c.ZohoTLD, CustomerPaymentsModule),
+		URL:           c.url("%s", CustomerPaymentsModule),
 		Method:        zoho.HTTPGet,
 		ResponseData:  &ListCustomerPaymentsResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -20,7 +20,7 @@ func (c *API) ListCustomerPayments() (data ListCustomerPaymentsResponse, err err
 		},
 		BodyFormat: zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
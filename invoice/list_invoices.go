@@ -12,7 +12,7 @@ func (c *API) ListInvoices() (data ListInvoicesResponse, err error) {
 
 	endpoint := zoho.Endpoint{
 		Name:          InvoicesModule,
-		URL:           fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s", c.ZohoTLD, InvoicesModule),
+		URL:           c.url("%s", InvoicesModule),
 		Method:        zoho.HTTPGet,
 		ResponseData:  &ListInvoicesResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -20,7 +20,7 @@ func (c *API) ListInvoices() (data ListInvoicesResponse, err error) {
 		},
 		BodyFormat: zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
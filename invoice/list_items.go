@@ -10,7 +10,7 @@ func (c *API) ListItems() (data ListItemsResponse, err error) {
 
 	endpoint := zoho.Endpoint{
 		Name:         ItemsModule,
-		URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s", c.ZohoTLD, ItemsModule),
+		URL:          c.url("%s", ItemsModule),
 		Method:       zoho.HTTPGet,
 		ResponseData: &ListItemsResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -18,7 +18,7 @@ func (c *API) ListItems() (data ListItemsResponse, err error) {
 		},
 		BodyFormat: zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -12,7 +12,7 @@ func (c *API) ListRecurringInvoices() (data ListRecurringInvoicesResponse, err e
 
 	endpoint := zoho.Endpoint{
 		Name:          RecurringInvoicesModule,
-		URL:           fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s", c.ZohoTLD, RecurringInvoicesModule),
+		URL:           c.url("%s", RecurringInvoicesModule),
 		Method:        zoho.HTTPGet,
 		ResponseData:  &ListRecurringInvoicesResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -20,7 +20,7 @@ func (c *API) ListRecurringInvoices() (data ListRecurringInvoicesResponse, err e
 		},
 		BodyFormat: zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -0,0 +1,13 @@
+package invoice
+
+// ListInvoicePayments returns the payments made for the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_List_invoice_payments
+func (c *API) ListInvoicePayments(invoiceId string) (data InvoicePaymentsResponse, err error) {
+	return c.Finance().ListInvoicePayments(invoiceId)
+}
+
+// DeleteInvoicePayment deletes the payment invoicePaymentId made for the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_Delete_a_payment
+func (c *API) DeleteInvoicePayment(invoiceId string, invoicePaymentId string) (data Response, err error) {
+	return c.Finance().DeleteInvoicePayment(invoiceId, invoicePaymentId)
+}
@@ -0,0 +1,35 @@
+package invoice
+
+import "github.com/iapon/zoho/finance"
+
+// SendPaymentReminder emails a reminder of the payment of the invoice invoiceId. The recipients of request
+// are optional, by default the reminder is sent to the contact persons of the customer.
+// https://www.zoho.com/invoice/api/v3/#Invoices_Email_payment_reminder
+func (c *API) SendPaymentReminder(request EmailInvoiceRequest, invoiceId string) (data Response, err error) {
+	return c.Finance().SendPaymentReminder(finance.EmailRequest{
+		SendFromOrgEmailID: request.SendFromOrgEmailId,
+		ToMailIDs:          request.ToMailIds,
+		CCMailIDs:          request.CcMailIds,
+		Subject:            request.Subject,
+		Body:               request.Body,
+	}, invoiceId)
+}
+
+// SendPaymentReminders emails a reminder of the payment of the invoices invoiceIds (10 at most)
+// to the contact persons of their customers
+// https://www.zoho.com/invoice/api/v3/#Invoices_Bulk_invoice_reminder
+func (c *API) SendPaymentReminders(invoiceIds ...string) (data Response, err error) {
+	return c.Finance().SendPaymentReminders(invoiceIds...)
+}
+
+// EnablePaymentReminder enables the automated payment reminders of the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_Enable_payment_reminder
+func (c *API) EnablePaymentReminder(invoiceId string) (data Response, err error) {
+	return c.Finance().EnableInvoicePaymentReminder(invoiceId)
+}
+
+// DisablePaymentReminder disables the automated payment reminders of the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_Disable_payment_reminder
+func (c *API) DisablePaymentReminder(invoiceId string) (data Response, err error) {
+	return c.Finance().DisableInvoicePaymentReminder(invoiceId)
+}
@@ -0,0 +1,28 @@
+package invoice
+
+import "github.com/iapon/zoho/finance"
+
+// ItemLine returns a line item billing quantity of the item itemId, the rate and name of the item are used
+func ItemLine(itemId string, quantity float64) InvoiceLineItem {
+	return finance.ItemLine(itemId, quantity)
+}
+
+// CustomLine returns a line item which is not an item of the organization, billing quantity at rate
+func CustomLine(name string, rate float64, quantity float64) InvoiceLineItem {
+	return finance.CustomLine(name, rate, quantity)
+}
+
+// NewCustomField returns the value of the custom field label
+func NewCustomField(label string, value interface{}) CustomFieldRequest {
+	return finance.NewCustomField(label, value)
+}
+
+// CustomFieldByID returns the value of the custom field customfieldID
+func CustomFieldByID(customfieldID string, value interface{}) CustomFieldRequest {
+	return finance.CustomFieldByID(customfieldID, value)
+}
+
+// NewAddress returns an address, the optional parts are set with the With methods
+func NewAddress(address string, city string, zip string, country string) ContactAddress {
+	return finance.NewAddress(address, city, zip, country)
+}
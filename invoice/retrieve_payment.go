@@ -12,7 +12,7 @@ func (c *API) RetrievePayment(paymentId string) (data RetrievePaymentResponse, e
 
 	endpoint := zoho.Endpoint{
 		Name:         CustomerPaymentsModule,
-		URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s/%s", c.ZohoTLD, CustomerPaymentsModule, paymentId),
+		URL:          c.url("%s/%s", CustomerPaymentsModule, paymentId),
 		Method:       zoho.HTTPGet,
 		ResponseData: &RetrievePaymentResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -20,7 +20,7 @@ func (c *API) RetrievePayment(paymentId string) (data RetrievePaymentResponse, e
 		},
 		BodyFormat: zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
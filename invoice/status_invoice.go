@@ -0,0 +1,37 @@
+package invoice
+
+// VoidInvoice marks the invoice invoiceId as void, its payments and credits are released
+// https://www.zoho.com/invoice/api/v3/#Invoices_Mark_as_void
+func (c *API) VoidInvoice(invoiceId string) (data Response, err error) {
+	return c.Finance().VoidInvoice(invoiceId)
+}
+
+// MarkInvoiceDraft marks the void invoice invoiceId as draft
+// https://www.zoho.com/invoice/api/v3/#Invoices_Mark_as_draft
+func (c *API) MarkInvoiceDraft(invoiceId string) (data Response, err error) {
+	return c.Finance().MarkInvoiceDraft(invoiceId)
+}
+
+// SubmitInvoice submits the invoice invoiceId for approval
+// https://www.zoho.com/invoice/api/v3/#Invoices_Submit_an_invoice_for_approval
+func (c *API) SubmitInvoice(invoiceId string) (data Response, err error) {
+	return c.Finance().SubmitInvoice(invoiceId)
+}
+
+// ApproveInvoice approves the invoice invoiceId submitted for approval
+// https://www.zoho.com/invoice/api/v3/#Invoices_Approve_an_invoice
+func (c *API) ApproveInvoice(invoiceId string) (data Response, err error) {
+	return c.Finance().ApproveInvoice(invoiceId)
+}
+
+// WriteOffInvoice writes off the balance of the invoice invoiceId
+// https://www.zoho.com/invoice/api/v3/#Invoices_Write_off_invoice
+func (c *API) WriteOffInvoice(invoiceId string) (data Response, err error) {
+	return c.Finance().WriteOffInvoice(invoiceId)
+}
+
+// CancelInvoiceWriteOff cancels the write off of the invoice invoiceId, its balance is due again
+// https://www.zoho.com/invoice/api/v3/#Invoices_Cancel_write_off
+func (c *API) CancelInvoiceWriteOff(invoiceId string) (data Response, err error) {
+	return c.Finance().CancelInvoiceWriteOff(invoiceId)
+}
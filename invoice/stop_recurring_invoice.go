@@ -11,10 +11,8 @@ import (
 func (c *API) StopRecurringInvoice(recurringInvoiceId string) (data StopRecurringInvoiceResponse, err error) {
 
 	endpoint := zoho.Endpoint{
-		Name: RecurringInvoicesModule,
-		URL: fmt.Sprintf(
-			"https://www.zohoapis.%s/invoice/v3/%s/status/stop", c.ZohoTLD, recurringInvoiceId,
-		),
+		Name:         RecurringInvoicesModule,
+		URL:          c.url("%s/status/stop", recurringInvoiceId),
 		Method:       zoho.HTTPPost,
 		ResponseData: &StopRecurringInvoiceResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -22,7 +20,7 @@ func (c *API) StopRecurringInvoice(recurringInvoiceId string) (data StopRecurrin
 		},
 		BodyFormat: zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
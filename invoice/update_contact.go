@@ -8,11 +8,14 @@ import (
 
 // https://www.zoho.com/invoice/api/v3/#Contacts_Update_a_Contact
 // func (c *API) UpdateContact(request interface{}, OrganizationID string, params map[string]zoho.Parameter) (data UpdateContactResponse, err error) {
-func (c *API) UpdateContact(request interface{}, contactId string) (data UpdateContactResponse, err error) {
+func (c *API) UpdateContact(request UpdateContactRequest, contactId string) (data UpdateContactResponse, err error) {
+	if err = request.Validate(); err != nil {
+		return UpdateContactResponse{}, fmt.Errorf("Failed to update contact: %s", err)
+	}
 
 	endpoint := zoho.Endpoint{
 		Name:         InvoicesModule,
-		URL:          fmt.Sprintf("%s%s/%s", InvoiceAPIEndpoint, ContactsModule, contactId),
+		URL:          c.url("%s/%s", ContactsModule, contactId),
 		Method:       zoho.HTTPPut,
 		ResponseData: &UpdateContactResponse{},
 		URLParameters: map[string]zoho.Parameter{
@@ -21,7 +24,7 @@ func (c *API) UpdateContact(request interface{}, contactId string) (data UpdateC
 		RequestBody: &request,
 		BodyFormat:  zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -31,7 +34,7 @@ func (c *API) UpdateContact(request interface{}, contactId string) (data UpdateC
 
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
-		return UpdateContactResponse{}, fmt.Errorf("Failed to create contact: %s", err)
+		return UpdateContactResponse{}, fmt.Errorf("Failed to update contact: %s", err)
 	}
 
 	if v, ok := endpoint.ResponseData.(*UpdateContactResponse); ok {
@@ -44,31 +47,6 @@ func (c *API) UpdateContact(request interface{}, contactId string) (data UpdateC
 	return UpdateContactResponse{}, fmt.Errorf("Data retrieved was not 'UpdateContactResponse'")
 }
 
-type UpdateContactRequest struct {
-	ContactName      string                  `json:"contact_name,omitempty"`
-	CompanyName      string                  `json:"company_name,omitempty"`
-	CustomerSubType  string                  `json:"customer_sub_type,omitempty"`
-	PaymentTerms     int64                   `json:"payment_terms,omitempty"`
-	CurrencyID       string                  `json:"currency_id,omitempty"`
-	Website          string                  `json:"website,omitempty"`
-	CustomFields     []CustomFieldRequest    `json:"custom_fields,omitempty"`
-	BillingAddress   ContactAddress          `json:"billing_address,omitempty"`
-	ShippingAddress  ContactAddress          `json:"shipping_address,omitempty"`
-	ContactPersons   []ContactPerson         `json:"contact_persons,omitempty"`
-	DefaultTemplates ContactDefaultTemplates `json:"default_templates,omitempty"`
-	LanguageCode     string                  `json:"language_code,omitempty"`
-	Notes            string                  `json:"notes,omitempty"`
-	PlaceOfContact   string                  `json:"place_of_contact,omitempty"`
-	GSTNo            string                  `json:"gst_no,omitempty"`
-	GSTTreatment     string                  `json:"gst_treatment,omitempty"`
-	TaxExemptionID   string                  `json:"tax_exemption_id,omitempty"`
-	TaxAuthorityID   string                  `json:"tax_authority_id,omitempty"`
-	TaxID            string                  `json:"tax_id,omitempty"`
-	IsTaxable        string                  `json:"is_taxable,omitempty"`
-	Facebook         string                  `json:"facebook,omitempty"`
-	Twitter          string                  `json:"twitter,omitempty"`
-}
-
 type UpdateContactResponse struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
@@ -7,19 +7,23 @@ import (
 )
 
 // https://www.zoho.com/invoice/api/v3/contact-persons/#update-a-contact-person
-func (c *API) UpdateContactPerson(request any, contactPersonID string) (data UpdateContactPersonResponse, err error) {
+func (c *API) UpdateContactPerson(request UpdateContactPersonRequest, contactPersonID string) (data UpdateContactPersonResponse, err error) {
+	if err = request.Validate(); err != nil {
+		return UpdateContactPersonResponse{}, fmt.Errorf("Failed to update contact person: %s", err)
+	}
+
 	endpoint := zoho.Endpoint{
 		Name:         InvoicesModule,
-		URL:          fmt.Sprintf("%s%s/%s/%s", InvoiceAPIEndpoint, ContactsModule, ContactsPersonSubModule, contactPersonID),
+		URL:          c.url("%s/%s/%s", ContactsModule, ContactsPersonSubModule, contactPersonID),
 		Method:       zoho.HTTPPut,
 		ResponseData: &UpdateContactPersonResponse{},
 		URLParameters: map[string]zoho.Parameter{
 			"filter_by": "",
 		},
-		RequestBody: &request,
+		RequestBody: request,
 		BodyFormat:  zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -47,6 +51,18 @@ type UpdateContactPersonRequest struct {
 	EnablePortal *bool `json:"enable_portal"`
 }
 
+// Validate checks the fields required to update a contact person: its contact,
+// and an email when the portal is enabled
+func (r UpdateContactPersonRequest) Validate() error {
+	if r.ContactID == "" {
+		return fmt.Errorf("a contact id is required")
+	}
+	if r.EnablePortal != nil && *r.EnablePortal && r.Email == "" {
+		return fmt.Errorf("the portal requires an email")
+	}
+	return nil
+}
+
 type UpdateContactPersonResponse struct {
 	Code          int             `json:"code"`
 	Message       string          `json:"message"`
@@ -8,20 +8,23 @@ import (
 
 // https://www.zoho.com/invoice/api/v3/#Recurring_Invoices_Update_Recurring_Invoice
 // func (c *API) UpdateRecurringInvoice(request interface{}, OrganizationID string, params map[string]zoho.Parameter) (data UpdateRecurringInvoiceRequest, err error) {
-func (c *API) UpdateRecurringInvoice(request interface{}, recurringInvoiceId string) (data UpdateRecurringInvoiceResponse, err error) {
+func (c *API) UpdateRecurringInvoice(request UpdateRecurringInvoiceRequest, recurringInvoiceId string) (data UpdateRecurringInvoiceResponse, err error) {
+	if err = request.Validate(); err != nil {
+		return UpdateRecurringInvoiceResponse{}, fmt.Errorf("Failed to update recurring invoice: %s", err)
+	}
 
 	endpoint := zoho.Endpoint{
 		Name:         ContactsModule,
-		URL:          fmt.Sprintf("https://www.zohoapis.%s/invoice/v3/%s/%s", c.ZohoTLD, RecurringInvoicesModule, recurringInvoiceId),
+		URL:          c.url("%s/%s", RecurringInvoicesModule, recurringInvoiceId),
 		Method:       zoho.HTTPPut,
 		ResponseData: &UpdateRecurringInvoiceResponse{},
 		URLParameters: map[string]zoho.Parameter{
 			"filter_by": "",
 		},
-		RequestBody: &request,
+		RequestBody: request,
 		BodyFormat:  zoho.JSON_STRING,
 		Headers: map[string]string{
-			InvoiceAPIEndpointHeader: c.OrganizationID,
+			c.organizationHeader(): c.OrganizationID,
 		},
 	}
 
@@ -70,6 +73,17 @@ type UpdateRecurringInvoiceRequest struct {
 	TaxExemptionId      string               `json:"tax_exemption_id,omitempty"`
 }
 
+// AddLineItem adds items to the line items of the recurring invoice
+func (r *UpdateRecurringInvoiceRequest) AddLineItem(items ...InvoiceLineItem) *UpdateRecurringInvoiceRequest {
+	r.LineItems = append(r.LineItems, items...)
+	return r
+}
+
+// Validate checks the recurring invoice the same way as CreateRecurringInvoiceRequest, the update replaces it
+func (r UpdateRecurringInvoiceRequest) Validate() error {
+	return CreateRecurringInvoiceRequest(r).Validate()
+}
+
 type UpdateRecurringInvoiceResponse struct {
 	Code             int64  `json:"code"`
 	Message          string `json:"message"`
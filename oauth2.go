@@ -297,6 +297,8 @@ const (
 	Expense Service = "ZohoExpense"
 	// Bookings is the Service portion of the scope string
 	Bookings Service = "zohobookings"
+	// Books is the Service portion of the scope string
+	Books Service = "ZohoBooks"
 )
 
 // Scope is a type for building scopes
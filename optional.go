@@ -0,0 +1,92 @@
+package zoho
+
+import "encoding/json"
+
+// fieldState is the state of an Optional field
+type fieldState uint8
+
+const (
+	fieldUnset fieldState = iota
+	fieldNull
+	fieldValue
+)
+
+// Optional holds a field value with three states:
+//   - unset, the zero value, the field was not returned by Zoho and is not sent by Marshal or MarshalPartial
+//   - null, the field is sent as null which clears it in Zoho
+//   - a value, set with Value or Set
+//
+// Request bodies are encoded with Marshal, which omits unset fields. json.Marshal encodes them as null,
+// unless the field has the `json:",omitzero"` tag option (Go 1.24 or above).
+type Optional[T any] struct {
+	value T
+	state fieldState
+}
+
+// Value returns an Optional set to v
+func Value[T any](v T) Optional[T] {
+	return Optional[T]{value: v, state: fieldValue}
+}
+
+// Null returns an Optional which is sent as null
+func Null[T any]() Optional[T] {
+	return Optional[T]{state: fieldNull}
+}
+
+// Get returns the value and whether a value is set, the zero value of T is returned when unset or null
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.state == fieldValue
+}
+
+// IsSet reports whether the field was touched, either set to a value or to null
+func (o Optional[T]) IsSet() bool {
+	return o.state != fieldUnset
+}
+
+// IsNull reports whether the field is null
+func (o Optional[T]) IsNull() bool {
+	return o.state == fieldNull
+}
+
+// IsZero reports whether the field is unset, it is used by the omitzero tag option
+func (o Optional[T]) IsZero() bool {
+	return o.state == fieldUnset
+}
+
+// Set sets the field to v
+func (o *Optional[T]) Set(v T) {
+	*o = Value(v)
+}
+
+// SetNull sets the field to null
+func (o *Optional[T]) SetNull() {
+	*o = Null[T]()
+}
+
+// Unset resets the field so it is not sent
+func (o *Optional[T]) Unset() {
+	*o = Optional[T]{}
+}
+
+// MarshalJSON encodes the value, or null when the field is null or unset (see Marshal to omit unset fields)
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.state != fieldValue {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null or a value of T, fields absent from the JSON stay unset
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		o.SetNull()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
@@ -0,0 +1,385 @@
+package zoho
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Settable is implemented by tri-state field types such as Optional, IsSet reports whether
+// the field was set to a value or to null
+type Settable interface {
+	IsSet() bool
+}
+
+// PartialRecord wraps a record so only its touched fields are sent, see MarshalPartial
+type PartialRecord struct {
+	Record interface{}
+}
+
+// Partial wraps record for use in the data of an update request, eg.
+//
+//	crm.UpdateRecordsData{Data: []interface{}{zoho.Partial(lead)}}
+func Partial(record interface{}) PartialRecord {
+	return PartialRecord{Record: record}
+}
+
+// MarshalJSON encodes the record with MarshalPartial
+func (p PartialRecord) MarshalJSON() ([]byte, error) {
+	return MarshalPartial(p.Record)
+}
+
+// Marshal encodes v like json.Marshal, except that Settable fields which are not set are omitted, at any depth.
+// Request bodies are encoded with Marshal, so unset Optional fields are never sent as null and do not clear
+// the fields in Zoho.
+func Marshal(v interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := marshalValue(buf, reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalPartial encodes a record struct as a JSON object holding only the fields which were touched:
+// Settable fields are included when IsSet reports true (so they can be cleared by sending null), other
+// fields are included when they are not the zero value of their type. Embedded structs are flattened like
+// encoding/json does, values which are not structs are encoded with Marshal.
+func MarshalPartial(record interface{}) ([]byte, error) {
+	v := reflect.ValueOf(record)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return []byte("null"), nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return Marshal(record)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := marshalStruct(buf, v, true); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var (
+	settableType      = reflect.TypeOf((*Settable)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// marshalValue writes the JSON of v to buf, values with their own marshaler and scalars are encoded by json.Marshal
+func marshalValue(buf *bytes.Buffer, v reflect.Value) error {
+	if !v.IsValid() {
+		buf.WriteString("null")
+		return nil
+	}
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		buf.WriteString("null")
+		return nil
+	}
+	if v.Kind() == reflect.Interface {
+		return marshalValue(buf, v.Elem())
+	}
+
+	t := v.Type()
+	if !mayHoldSettable(t) || t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) {
+		return writeJSON(buf, v.Interface())
+	}
+	if v.CanAddr() && (reflect.PtrTo(t).Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType)) {
+		return writeJSON(buf, v.Addr().Interface())
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		return marshalValue(buf, v.Elem())
+	case reflect.Struct:
+		return marshalStruct(buf, v, false)
+	case reflect.Slice:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			return writeJSON(buf, v.Interface())
+		}
+		return marshalElems(buf, v)
+	case reflect.Array:
+		return marshalElems(buf, v)
+	case reflect.Map:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		if t.Key().Kind() != reflect.String || t.Key().Implements(textMarshalerType) {
+			return writeJSON(buf, v.Interface())
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSON(buf, k.String()); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := marshalValue(buf, v.MapIndex(k)); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	}
+	return writeJSON(buf, v.Interface())
+}
+
+var settableTypes sync.Map
+
+// mayHoldSettable reports whether a value of type t may hold a Settable field, when it cannot the value is
+// encoded by json.Marshal. Interfaces may hold anything and are inspected when encoded.
+func mayHoldSettable(t reflect.Type) bool {
+	if v, ok := settableTypes.Load(t); ok {
+		return v.(bool)
+	}
+	// Recursive types are assumed not to hold one while they are inspected
+	settableTypes.Store(t, false)
+	may := false
+	switch t.Kind() {
+	case reflect.Interface:
+		may = true
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		may = mayHoldSettable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField() && !may; i++ {
+			ft := t.Field(i).Type
+			may = ft.Implements(settableType) || mayHoldSettable(ft)
+		}
+	}
+	if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) {
+		may = false
+	}
+	settableTypes.Store(t, may)
+	return may
+}
+
+// marshalElems writes the elements of the slice or array v as a JSON array
+func marshalElems(buf *bytes.Buffer, v reflect.Value) error {
+	buf.WriteByte('[')
+	for i := 0; i < v.Len(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := marshalValue(buf, v.Index(i)); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+// marshalStruct writes the struct v as a JSON object. Unset Settable fields are always omitted, in partial
+// mode nil pointers and zero values are omitted too, otherwise the omitempty tag option is honoured.
+func marshalStruct(buf *bytes.Buffer, v reflect.Value, partial bool) error {
+	buf.WriteByte('{')
+	written := 0
+	for _, f := range jsonFields(v.Type()) {
+		fv, ok := fieldByIndex(v, f.index)
+		if !ok || !fv.CanInterface() {
+			continue
+		}
+
+		if s, ok := fv.Interface().(Settable); ok && !s.IsSet() {
+			continue
+		}
+		if partial {
+			if (fv.Kind() == reflect.Ptr && fv.IsNil()) || fv.IsZero() {
+				continue
+			}
+		} else if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+
+		value := &bytes.Buffer{}
+		if err := marshalValue(value, fv); err != nil {
+			return fmt.Errorf("Failed to encode field %s: %s", f.name, err)
+		}
+		b := value.Bytes()
+		if f.quoted {
+			b = quoteScalar(fv, b)
+		}
+
+		if written > 0 {
+			buf.WriteByte(',')
+		}
+		written++
+		if err := writeJSON(buf, f.name); err != nil {
+			return err
+		}
+		buf.WriteByte(':')
+		buf.Write(b)
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// jsonField is a field of a struct as encoding/json sees it, with embedded structs flattened
+type jsonField struct {
+	name      string
+	index     []int
+	tagged    bool
+	omitEmpty bool
+	quoted    bool
+}
+
+// jsonFields returns the encoded fields of the struct type t in field order, resolving the names
+// shadowed by embedding with the rules of encoding/json: the shallowest field wins, then the tagged one
+func jsonFields(t reflect.Type) []jsonField {
+	var all []jsonField
+	embedded := map[reflect.Type]bool{t: true}
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			parts := strings.Split(tag, ",")
+			name := parts[0]
+			idx := append(append([]int{}, index...), i)
+
+			if sf.Anonymous && name == "" {
+				ft := sf.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					// A struct embedding itself through a pointer is only walked once
+					if !embedded[ft] {
+						embedded[ft] = true
+						walk(ft, idx)
+					}
+					continue
+				}
+			}
+			if !sf.IsExported() {
+				continue
+			}
+
+			f := jsonField{name: name, index: idx, tagged: name != ""}
+			if name == "" {
+				f.name = sf.Name
+			}
+			for _, opt := range parts[1:] {
+				switch opt {
+				case "omitempty":
+					f.omitEmpty = true
+				case "string":
+					f.quoted = true
+				}
+			}
+			all = append(all, f)
+		}
+	}
+	walk(t, nil)
+
+	byName := map[string][]jsonField{}
+	for _, f := range all {
+		byName[f.name] = append(byName[f.name], f)
+	}
+	var fields []jsonField
+	for _, f := range all {
+		if dominantField(byName[f.name]) == len(f.index) && isDominant(f, byName[f.name]) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// dominantField returns the depth of the shallowest of fields
+func dominantField(fields []jsonField) int {
+	depth := len(fields[0].index)
+	for _, f := range fields[1:] {
+		if len(f.index) < depth {
+			depth = len(f.index)
+		}
+	}
+	return depth
+}
+
+// isDominant reports whether f is the only field of its name at the shallowest depth,
+// or the only tagged one, otherwise the name is ambiguous and dropped
+func isDominant(f jsonField, fields []jsonField) bool {
+	var shallowest, tagged int
+	for _, o := range fields {
+		if len(o.index) == len(f.index) {
+			shallowest++
+			if o.tagged {
+				tagged++
+			}
+		}
+	}
+	return shallowest == 1 || (f.tagged && tagged == 1)
+}
+
+// fieldByIndex returns the field of v at index, false when an embedded pointer on the way is nil
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// isEmptyValue reports whether v is empty for the omitempty tag option
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Interface, reflect.Ptr:
+		return v.IsZero()
+	}
+	return false
+}
+
+// quoteScalar applies the string tag option: scalars are encoded inside a JSON string
+func quoteScalar(v reflect.Value, b []byte) []byte {
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		q, err := json.Marshal(string(b))
+		if err == nil {
+			return q
+		}
+	}
+	return b
+}
+
+// writeJSON writes the json.Marshal encoding of v to buf
+func writeJSON(buf *bytes.Buffer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
@@ -0,0 +1,114 @@
+package recruit
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// BatchOptions controls how InsertRecordsBatched, UpdateRecordsBatched, UpsertRecordsBatched and
+// DeleteRecordsBatched split their input
+type BatchOptions = zoho.BatchOptions
+
+// BatchResult is the merged outcome of a batched write, see zoho.BatchResult
+type BatchResult = zoho.BatchResult
+
+// RecordError is the error reported for a single record of a batched write
+type RecordError = zoho.RecordError
+
+// InsertRecordsBatched inserts any number of records into module, splitting them in requests of at most
+// zoho.MaxRecordsPerRequest records. records must be a slice. The result maps every input index to the new
+// record id or a *RecordError, a failure of one record does not stop the others from being inserted.
+// https://www.zoho.com/recruit/developer-guide/apiv2/insert-records.html
+func (c *API) InsertRecordsBatched(module Module, records interface{}, trigger []string, opts BatchOptions) (data BatchResult, err error) {
+	return c.writeRecordsBatched("failed to insert records", zoho.HTTPPost, fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/%s", c.ZohoTLD, module),
+		module, records, func(batch interface{}) interface{} {
+			return InsertRecords{Data: batch, Trigger: trigger}
+		}, opts)
+}
+
+// UpdateRecordsBatched updates any number of records of module, every record must contain its id.
+// See InsertRecordsBatched for how the records are split and the results merged.
+// https://www.zoho.com/recruit/developer-guide/apiv2/update-records.html
+func (c *API) UpdateRecordsBatched(module Module, records interface{}, trigger []string, opts BatchOptions) (data BatchResult, err error) {
+	return c.writeRecordsBatched("failed to update records", zoho.HTTPPut, fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/%s", c.ZohoTLD, module),
+		module, records, func(batch interface{}) interface{} {
+			return InsertRecords{Data: batch, Trigger: trigger}
+		}, opts)
+}
+
+// UpsertRecordsBatched inserts or updates any number of records of module, matching existing records using
+// duplicateCheckFields. See InsertRecordsBatched for how the records are split and the results merged.
+// https://www.zoho.com/recruit/developer-guide/apiv2/upsert-records.html
+func (c *API) UpsertRecordsBatched(module Module, records interface{}, duplicateCheckFields []string, trigger []string, opts BatchOptions) (data BatchResult, err error) {
+	return c.writeRecordsBatched("failed to upsert records", zoho.HTTPPost, fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/%s/upsert", c.ZohoTLD, module),
+		module, records, func(batch interface{}) interface{} {
+			return UpsertRecords{Data: batch, DuplicateCheckFields: duplicateCheckFields, Trigger: trigger}
+		}, opts)
+}
+
+// DeleteRecordsBatched deletes any number of records of module by id, sending at most zoho.MaxRecordsPerRequest ids per request
+// https://www.zoho.com/recruit/developer-guide/apiv2/delete-records.html
+func (c *API) DeleteRecordsBatched(module Module, ids []string, opts BatchOptions) (data BatchResult, err error) {
+	if len(ids) == 0 {
+		return BatchResult{}, fmt.Errorf("failed to delete records, must provide at least 1 ID")
+	}
+
+	return zoho.RunBatches(len(ids), opts, func(start, end int) ([]zoho.RecordStatus, error) {
+		endpoint := zoho.Endpoint{
+			Name:         "DeleteRecordsBatched",
+			URL:          fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/%s", c.ZohoTLD, module),
+			Method:       zoho.HTTPDelete,
+			ResponseData: &zoho.RecordsStatusResponse{},
+			URLParameters: map[string]zoho.Parameter{
+				"ids": zoho.Parameter(strings.Join(ids[start:end], ",")),
+			},
+			PartialSuccess: true,
+		}
+
+		return c.sendRecordsBatch(&endpoint, module, "failed to delete records")
+	}), nil
+}
+
+// writeRecordsBatched sends records to url in batches, body wraps each batch in the request data of the endpoint
+func (c *API) writeRecordsBatched(failure string, method zoho.HTTPMethod, url string, module Module, records interface{}, body func(batch interface{}) interface{}, opts BatchOptions) (BatchResult, error) {
+	v := reflect.ValueOf(records)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		return BatchResult{}, fmt.Errorf("%s of %s, records must be a slice", failure, module)
+	}
+
+	return zoho.RunBatches(v.Len(), opts, func(start, end int) ([]zoho.RecordStatus, error) {
+		endpoint := zoho.Endpoint{
+			Name:           "RecordsBatched",
+			URL:            url,
+			Method:         method,
+			ResponseData:   &zoho.RecordsStatusResponse{},
+			RequestBody:    body(v.Slice(start, end).Interface()),
+			PartialSuccess: true,
+		}
+
+		return c.sendRecordsBatch(&endpoint, module, failure)
+	}), nil
+}
+
+// sendRecordsBatch performs a single batch request and returns the status of each record
+func (c *API) sendRecordsBatch(endpoint *zoho.Endpoint, module Module, failure string) ([]zoho.RecordStatus, error) {
+	err := c.Zoho.HTTPRequest(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%s of %s: %s", failure, module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*zoho.RecordsStatusResponse); ok {
+		if len(v.Data) == 0 && v.Status == "error" {
+			return nil, fmt.Errorf("%s of %s: %s: %s", failure, module, v.Code, v.Message)
+		}
+		return v.Data, nil
+	}
+
+	return nil, fmt.Errorf("data returned was not 'RecordsStatusResponse'")
+}
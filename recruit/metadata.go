@@ -9,11 +9,11 @@ import (
 // GetAllMetadata returns the metadata for fields, layouts, and related lists for the specified module.
 // It lists the entire fields available and related list for that module.
 // https://www.zoho.com/recruit/developer-guide/apiv2/module-meta.html
-// https://recruit.zoho.%s/v2/settings/modules
+// https://recruit.zoho.%s/recruit/v2/settings/modules
 func (c *API) GetAllMetadata() (data AllMetadataResponse, err error) {
 	endpoint := zoho.Endpoint{
 		Name:         "GetAllMetadata",
-		URL:          fmt.Sprintf("https://recruit.zoho.%s/v2/settings/modules", c.ZohoTLD),
+		URL:          fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/settings/modules", c.ZohoTLD),
 		Method:       zoho.HTTPGet,
 		ResponseData: &AllMetadataResponse{},
 	}
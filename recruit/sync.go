@@ -0,0 +1,71 @@
+package recruit
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// ChangeEvent is a created, modified or deleted record emitted by a Syncer
+type ChangeEvent = zoho.ChangeEvent
+
+// Syncer keeps an external copy of Recruit modules up to date. Every call to Sync only requests the records
+// modified (If-Modified-Since) and deleted since the watermark saved by the previous call.
+type Syncer struct {
+	api   *API
+	Store zoho.WatermarkLoaderSaver
+	// Fields limits the fields returned for modified records, all fields are returned when empty.
+	// id and Modified_Time are always requested, the watermark is computed from them.
+	Fields []string
+}
+
+// NewSyncer returns a *recruit.Syncer which saves its watermarks in store, when store is nil the
+// watermarks are saved to the file '.watermarks.zoho' in the current directory
+func NewSyncer(c *API, store zoho.WatermarkLoaderSaver) *Syncer {
+	if store == nil {
+		store = &zoho.FileWatermarks{Path: "./.watermarks.zoho"}
+	}
+	return &Syncer{
+		api:   c,
+		Store: store,
+	}
+}
+
+// Sync emits the changes of module since the last successful Sync to handler, upserts are emitted
+// before deletions. Use ChangeEvent.Decode to unmarshal the record into a record type element.
+// https://www.zoho.com/recruit/developer-guide/apiv2/get-records.html
+// https://www.zoho.com/recruit/developer-guide/apiv2/get-deleted-records.html
+func (s *Syncer) Sync(module Module, handler func(ChangeEvent) error) error {
+	params := map[string]zoho.Parameter{}
+	if len(s.Fields) > 0 {
+		params["fields"] = zoho.Parameter(strings.Join(s.Fields, ","))
+	}
+
+	err := s.api.Zoho.SyncRecords(zoho.SyncRequest{
+		Key:        s.key(module),
+		Module:     string(module),
+		RecordsURL: fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/%s", s.api.ZohoTLD, module),
+		DeletedURL: fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/%s/deleted", s.api.ZohoTLD, module),
+		Params:     params,
+		Store:      s.Store,
+	}, handler)
+	if err != nil {
+		return fmt.Errorf("failed to sync %s: %s", module, err)
+	}
+
+	return nil
+}
+
+// Reset clears the watermark of module, the next Sync emits every record of the module
+func (s *Syncer) Reset(module Module) error {
+	if err := s.Store.SaveWatermark(s.key(module), time.Time{}); err != nil {
+		return err
+	}
+	return s.Store.SaveWatermark(zoho.DeletedWatermarkKey(s.key(module)), time.Time{})
+}
+
+func (s *Syncer) key(module Module) string {
+	return fmt.Sprintf("recruit/%s", module)
+}
@@ -7,27 +7,14 @@ import (
 	zoho "github.com/iapon/zoho"
 )
 
+// tags returns the tags endpoints shared with CRM, see zoho.TagsAPI. The requests are decoded into the types of this package.
+func (c *API) tags() zoho.TagsAPI {
+	return zoho.TagsAPI{Zoho: c.Zoho, BaseURL: fmt.Sprintf("https://recruit.zoho.%s/recruit/v2", c.ZohoTLD)}
+}
+
 // https://www.zoho.com/recruit/developer-guide/apiv2/create-tag.html
 func (c *API) CreateTags(request CreateTagsRequest, params map[string]zoho.Parameter) (data CreateTagsResponse, err error) {
-	endpoint := zoho.Endpoint{
-		Name:         "CreateTags",
-		URL:          fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/settings/tags", c.ZohoTLD),
-		Method:       zoho.HTTPPost,
-		ResponseData: &CreateTagsResponse{},
-		RequestBody:  request,
-		BodyFormat:   zoho.JSON,
-		URLParameters: map[string]zoho.Parameter{
-			"module": "", // mandatory
-		},
-	}
-
-	if len(params) > 0 {
-		for k, v := range params {
-			endpoint.URLParameters[k] = v
-		}
-	}
-
-	// pp.Printf("%s\n", endpoint)
+	endpoint := c.tags().CreateTagsEndpoint(request, params, &CreateTagsResponse{})
 
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
@@ -76,22 +63,7 @@ type CreateTagsResponse struct {
 
 // https://www.zoho.com/recruit/developer-guide/apiv2/add-tags.html
 func (c *API) AddTagsToIDs(module Module, params map[string]zoho.Parameter) (data AddTagsResponse, err error) {
-	endpoint := zoho.Endpoint{
-		Name:         "AddTagsToIDs",
-		URL:          fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/%s/actions/add_tags", c.ZohoTLD, module),
-		Method:       zoho.HTTPPost,
-		ResponseData: &AddTagsResponse{},
-		URLParameters: map[string]zoho.Parameter{
-			"tag_names": "",
-			"ids":       "",
-		},
-	}
-
-	if len(params) > 0 {
-		for k, v := range params {
-			endpoint.URLParameters[k] = v
-		}
-	}
+	endpoint := c.tags().RecordTagsEndpoint("add_tags", string(module), "", params, &AddTagsResponse{})
 
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
@@ -107,21 +79,7 @@ func (c *API) AddTagsToIDs(module Module, params map[string]zoho.Parameter) (dat
 
 // https://www.zoho.com/recruit/developer-guide/apiv2/add-tags.html
 func (c *API) AddTagsToId(module Module, ID string, params map[string]zoho.Parameter) (data AddTagsResponse, err error) {
-	endpoint := zoho.Endpoint{
-		Name:         "AddTagsToId",
-		URL:          fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/%s/%s/actions/add_tags", c.ZohoTLD, module, ID),
-		Method:       zoho.HTTPPost,
-		ResponseData: &AddTagsResponse{},
-		URLParameters: map[string]zoho.Parameter{
-			"tag_names": "",
-		},
-	}
-
-	if len(params) > 0 {
-		for k, v := range params {
-			endpoint.URLParameters[k] = v
-		}
-	}
+	endpoint := c.tags().RecordTagsEndpoint("add_tags", string(module), ID, params, &AddTagsResponse{})
 
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
@@ -158,12 +116,7 @@ func (c *API) DeleteTagById(tagID string) (data DeleteTagResponse, err error) {
 		return DeleteTagResponse{}, fmt.Errorf("failed to delete Tag, must provide tagID")
 	}
 
-	endpoint := zoho.Endpoint{
-		Name:         "DeleteTagById",
-		URL:          fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/settings/tags/%s", c.ZohoTLD, tagID),
-		Method:       zoho.HTTPDelete,
-		ResponseData: &DeleteTagResponse{},
-	}
+	endpoint := c.tags().DeleteTagEndpoint(tagID, &DeleteTagResponse{})
 
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
@@ -193,24 +146,7 @@ func (c *API) GetTagsList(module Module, params map[string]zoho.Parameter) (data
 	if len(module) == 0 {
 		return TagsListResponse{}, fmt.Errorf("failed to list Tags, module name is missing")
 	}
-	endpoint := zoho.Endpoint{
-		Name:         "GetTagsList",
-		URL:          fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/settings/tags", c.ZohoTLD),
-		Method:       zoho.HTTPGet,
-		ResponseData: &TagsListResponse{},
-		URLParameters: map[string]zoho.Parameter{
-			"module":  zoho.Parameter(module), // mandatory
-			"my_tags": "",
-		},
-	}
-
-	if len(params) > 0 {
-		for k, v := range params {
-			endpoint.URLParameters[k] = v
-		}
-	}
-
-	// log.Printf("endpoint: %+v", endpoint)
+	endpoint := c.tags().GetTagsEndpoint(string(module), params, &TagsListResponse{})
 
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
@@ -246,14 +182,7 @@ type TagsListResponse struct {
 
 // https://www.zoho.com/recruit/developer-guide/apiv2/update-tags.html
 func (c *API) UpdateTag(ID string, request UpdateTagRequest) (data UpdateTagResponse, err error) {
-	endpoint := zoho.Endpoint{
-		Name:         "UpdateTag",
-		URL:          fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/settings/tags/%s", c.ZohoTLD, ID),
-		Method:       zoho.HTTPPut,
-		ResponseData: &UpdateTagResponse{},
-		RequestBody:  request,
-		BodyFormat:   zoho.JSON,
-	}
+	endpoint := c.tags().UpdateTagEndpoint(ID, request, nil, &UpdateTagResponse{})
 
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
@@ -294,25 +223,47 @@ type UpdateTagResponse struct {
 	} `json:"tags"`
 }
 
-// https://www.zoho.com/recruit/developer-guide/apiv2/remove-tags.html
-func (c *API) RemoveTagsFromIDs(module Module, params map[string]zoho.Parameter) (data RemoveTagsResponse, err error) {
-	endpoint := zoho.Endpoint{
-		Name:         "RemoveTagsFromIDs",
-		URL:          fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/%s/actions/remove_tags", c.ZohoTLD, module),
-		Method:       zoho.HTTPPost,
-		ResponseData: &RemoveTagsResponse{},
-		URLParameters: map[string]zoho.Parameter{
-			"tag_names": "",
-			"ids":       "",
-		},
+// MergeTags merges the tag conflictID into the tag ID
+// https://www.zoho.com/recruit/developer-guide/apiv2/merge-tags.html
+func (c *API) MergeTags(ID string, conflictID string) (data UpdateTagResponse, err error) {
+	endpoint := c.tags().MergeTagsEndpoint(ID, conflictID, &UpdateTagResponse{})
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return UpdateTagResponse{}, fmt.Errorf("failed to merge Tags: %s", err)
 	}
 
-	if len(params) > 0 {
-		for k, v := range params {
-			endpoint.URLParameters[k] = v
-		}
+	if v, ok := endpoint.ResponseData.(*UpdateTagResponse); ok {
+		return *v, nil
 	}
 
+	return UpdateTagResponse{}, fmt.Errorf("data returned was not 'UpdateTagResponse'")
+}
+
+// GetTagRecordCount returns the number of records of module tagged with the tag ID
+// https://www.zoho.com/recruit/developer-guide/apiv2/get-record-count-tag.html
+func (c *API) GetTagRecordCount(module Module, ID string) (data TagRecordCountResponse, err error) {
+	endpoint := c.tags().TagRecordCountEndpoint(string(module), ID, &TagRecordCountResponse{})
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TagRecordCountResponse{}, fmt.Errorf("failed to retrieve record count of Tag: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TagRecordCountResponse); ok {
+		return *v, nil
+	}
+
+	return TagRecordCountResponse{}, fmt.Errorf("data returned was not 'TagRecordCountResponse'")
+}
+
+// TagRecordCountResponse is the data returned by GetTagRecordCount
+type TagRecordCountResponse = zoho.TagRecordCountResponse
+
+// https://www.zoho.com/recruit/developer-guide/apiv2/remove-tags.html
+func (c *API) RemoveTagsFromIDs(module Module, params map[string]zoho.Parameter) (data RemoveTagsResponse, err error) {
+	endpoint := c.tags().RecordTagsEndpoint("remove_tags", string(module), "", params, &RemoveTagsResponse{})
+
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
 		return RemoveTagsResponse{}, fmt.Errorf("failed to insert Tag(s): %s", err)
@@ -327,21 +278,7 @@ func (c *API) RemoveTagsFromIDs(module Module, params map[string]zoho.Parameter)
 
 // https://www.zoho.com/recruit/developer-guide/apiv2/remove-tags.html
 func (c *API) RemoveTagsFromId(module Module, ID string, params map[string]zoho.Parameter) (data RemoveTagsResponse, err error) {
-	endpoint := zoho.Endpoint{
-		Name:         "RemoveTagsFromId",
-		URL:          fmt.Sprintf("https://recruit.zoho.%s/recruit/v2/%s/%s/actions/remove_tags", c.ZohoTLD, module, ID),
-		Method:       zoho.HTTPPost,
-		ResponseData: &RemoveTagsResponse{},
-		URLParameters: map[string]zoho.Parameter{
-			"tag_names": "",
-		},
-	}
-
-	if len(params) > 0 {
-		for k, v := range params {
-			endpoint.URLParameters[k] = v
-		}
-	}
+	endpoint := c.tags().RecordTagsEndpoint("remove_tags", string(module), ID, params, &RemoveTagsResponse{})
 
 	err = c.Zoho.HTTPRequest(&endpoint)
 	if err != nil {
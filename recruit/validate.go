@@ -0,0 +1,57 @@
+package recruit
+
+import (
+	"fmt"
+
+	zoho "github.com/iapon/zoho"
+)
+
+// Validator checks records against the field metadata of a module, see zoho.Validator
+type Validator = zoho.Validator
+
+// ValidationErrors are the field errors returned by ValidateRecords
+type ValidationErrors = zoho.ValidationErrors
+
+// NewValidator returns a Validator for module built from its fields metadata
+func (c *API) NewValidator(module Module) (*Validator, error) {
+	fields, err := c.GetFieldsMetadata(map[string]zoho.Parameter{"module": zoho.Parameter(module)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create validator for %s: %s", module, err)
+	}
+
+	rules := make([]zoho.FieldRule, 0, len(fields.Fields))
+	for _, f := range fields.Fields {
+		rule := zoho.FieldRule{
+			APIName:  f.APIName,
+			DataType: f.DataType,
+			Length:   f.Length,
+			Required: f.SystemMandatory,
+			ReadOnly: f.ReadOnly || f.FieldReadOnly,
+		}
+		switch f.DataType {
+		case "formula", "autonumber":
+			rule.ReadOnly = true
+		}
+		for _, p := range f.PickListValues {
+			// Values are returned as objects holding the actual and display values
+			if v, ok := p.(map[string]interface{}); ok {
+				if s, ok := v["actual_value"].(string); ok {
+					rule.PickListValues = append(rule.PickListValues, s)
+				}
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	return zoho.NewValidator(string(module), rules), nil
+}
+
+// ValidateRecords checks the records of request before they are sent with InsertRecords (zoho.ValidateInsert)
+// or as updates of existing records by id (zoho.ValidateUpdate), invalid fields are returned as ValidationErrors
+func (c *API) ValidateRecords(request InsertRecords, module Module, mode zoho.ValidationMode) error {
+	v, err := c.NewValidator(module)
+	if err != nil {
+		return err
+	}
+	return v.Validate(request.Data, mode)
+}
@@ -0,0 +1,340 @@
+package zoho
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WatermarkLoaderSaver persists the time up to which a module has been synchronised.
+// It can be implemented to store watermarks alongside the synchronised data, by default
+// FileWatermarks is used.
+type WatermarkLoaderSaver interface {
+	LoadWatermark(key string) (time.Time, error)
+	SaveWatermark(key string, t time.Time) error
+}
+
+// FileWatermarks is a WatermarkLoaderSaver which keeps the watermarks of every module in a JSON file
+type FileWatermarks struct {
+	Path string
+	mu   sync.Mutex
+}
+
+// LoadWatermark returns the watermark saved for key, the zero time is returned when the module was never synchronised
+func (f *FileWatermarks) LoadWatermark(key string) (time.Time, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	marks, err := f.read()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return marks[key], nil
+}
+
+// SaveWatermark stores t as the watermark of key
+func (f *FileWatermarks) SaveWatermark(key string, t time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	marks, err := f.read()
+	if err != nil {
+		return err
+	}
+	marks[key] = t
+
+	b, err := json.MarshalIndent(marks, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(f.Path, b, 0666); err != nil {
+		return fmt.Errorf("Failed to save watermarks to '%s': %s", f.Path, err)
+	}
+	return nil
+}
+
+func (f *FileWatermarks) read() (map[string]time.Time, error) {
+	marks := map[string]time.Time{}
+
+	b, err := ioutil.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return marks, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read watermarks from '%s': %s", f.Path, err)
+	}
+	if len(b) == 0 {
+		return marks, nil
+	}
+
+	if err = json.Unmarshal(b, &marks); err != nil {
+		return nil, fmt.Errorf("Failed to decode watermarks from '%s': %s", f.Path, err)
+	}
+	return marks, nil
+}
+
+// ChangeOperation is the kind of change reported by a sync
+type ChangeOperation string
+
+const (
+	// ChangeUpsert - the record was created or modified since the last sync
+	ChangeUpsert ChangeOperation = "upsert"
+	// ChangeDelete - the record was deleted since the last sync
+	ChangeDelete ChangeOperation = "delete"
+)
+
+// ChangeEvent is a single change emitted by a sync. Record holds the raw record for upserts and
+// the deleted record entry (id, display_name, deleted_by, ...) for deletes.
+type ChangeEvent struct {
+	Module    string
+	Operation ChangeOperation
+	ID        string
+	Time      time.Time
+	Record    json.RawMessage
+}
+
+// Decode unmarshals the record of the event into v, v should be the element type of a record struct
+func (e ChangeEvent) Decode(v interface{}) error {
+	return json.Unmarshal(e.Record, v)
+}
+
+// SyncRequest describes the endpoints used by SyncRecords for a single module
+type SyncRequest struct {
+	// Key identifies the module in the WatermarkLoaderSaver, eg. "crm/Leads"
+	Key    string
+	Module string
+	// RecordsURL lists the records of the module and accepts the If-Modified-Since header
+	RecordsURL string
+	// DeletedURL lists the deleted records of the module, deletions are skipped when empty
+	DeletedURL string
+	// Params are added to the records request, eg. "fields" or "cvid". When "fields" is set, id and
+	// Modified_Time are always added to it as the watermark is computed from them.
+	Params  map[string]Parameter
+	Headers map[string]string
+	Store   WatermarkLoaderSaver
+}
+
+// DeletedWatermarkKey returns the key of the watermark of the deleted records of the module key,
+// the deletions are tracked separately from the modified records
+func DeletedWatermarkKey(key string) string {
+	return key + "/deleted"
+}
+
+// syncPageLimit is the number of pages Zoho returns for a single query (200 records per page),
+// after which the query is restarted from the last modified time seen
+const syncPageLimit = 10
+
+// SyncRecords emits every record of the module modified since the saved watermark as a ChangeUpsert event,
+// then every record deleted since the watermark of the deletions as a ChangeDelete event. Records are requested
+// in ascending Modified_Time order. Modified and deleted records have their own watermark, so a deletion never
+// moves the watermark past a modification which was not seen yet. The records modified or deleted at the time of
+// a watermark are emitted again by the next run, so none sharing that time is missed. The watermarks are only
+// advanced once every event has been handled without error, so a failed run is retried from the same point;
+// handlers should therefore be idempotent.
+func (z *Zoho) SyncRecords(request SyncRequest, handler func(ChangeEvent) error) error {
+	if request.Store == nil {
+		return fmt.Errorf("Failed to sync %s, a watermark store is required", request.Module)
+	}
+
+	since, err := request.Store.LoadWatermark(request.Key)
+	if err != nil {
+		return fmt.Errorf("Failed to sync %s: %s", request.Module, err)
+	}
+	watermark := since
+
+	deletedKey := DeletedWatermarkKey(request.Key)
+	deletedSince, err := request.Store.LoadWatermark(deletedKey)
+	if err != nil {
+		return fmt.Errorf("Failed to sync %s: %s", request.Module, err)
+	}
+	if deletedSince.IsZero() {
+		// Stores written before deletions had their own watermark only hold the watermark of the module
+		deletedSince = since
+	}
+	deletedWatermark := deletedSince
+
+	params := map[string]Parameter{}
+	for k, v := range request.Params {
+		params[k] = v
+	}
+	if fields, ok := params["fields"]; ok && fields != "" {
+		params["fields"] = Parameter(syncFields(string(fields)))
+	}
+
+	// Modified and created records
+	query := since
+	for page := 1; ; page++ {
+		endpoint := Endpoint{
+			Name:         "sync",
+			URL:          request.RecordsURL,
+			Method:       HTTPGet,
+			ResponseData: &syncRecordsResponse{},
+			URLParameters: map[string]Parameter{
+				"sort_by":    "Modified_Time",
+				"sort_order": "asc",
+				"page":       Parameter(strconv.Itoa(page)),
+				"per_page":   "200",
+			},
+			Headers: syncHeaders(request.Headers, query),
+		}
+		for k, v := range params {
+			endpoint.URLParameters[k] = v
+		}
+
+		if err = z.HTTPRequest(&endpoint); err != nil {
+			return fmt.Errorf("Failed to sync records of %s: %s", request.Module, err)
+		}
+
+		resp, ok := endpoint.ResponseData.(*syncRecordsResponse)
+		if !ok {
+			return fmt.Errorf("Data returned was not 'syncRecordsResponse'")
+		}
+
+		for _, raw := range resp.Data {
+			meta := struct {
+				ID           string `json:"id"`
+				ModifiedTime string `json:"Modified_Time"`
+			}{}
+			if err = json.Unmarshal(raw, &meta); err != nil {
+				return fmt.Errorf("Failed to decode record of %s: %s", request.Module, err)
+			}
+			modified, err := time.Parse(time.RFC3339, meta.ModifiedTime)
+			if err != nil {
+				return fmt.Errorf("Failed to sync records of %s: record %s has no valid Modified_Time (%q): %s", request.Module, meta.ID, meta.ModifiedTime, err)
+			}
+
+			if err = handler(ChangeEvent{Module: request.Module, Operation: ChangeUpsert, ID: meta.ID, Time: modified, Record: raw}); err != nil {
+				return err
+			}
+			if modified.After(watermark) {
+				watermark = modified
+			}
+		}
+
+		if !resp.Info.MoreRecords {
+			break
+		}
+		if page == syncPageLimit {
+			if !watermark.After(query) {
+				return fmt.Errorf("Failed to sync records of %s: more than %d records were modified at %s", request.Module, syncPageLimit*200, query)
+			}
+			// Restart the query from the newest record seen, records sharing that time are emitted again
+			query = watermark
+			page = 0
+		}
+	}
+
+	// Deleted records, the records deleted at the watermark are requested again as other records may have been
+	// deleted in the same second after the last run
+	if request.DeletedURL != "" {
+		seen := map[string]bool{}
+		for page := 1; ; page++ {
+			endpoint := Endpoint{
+				Name:         "sync",
+				URL:          request.DeletedURL,
+				Method:       HTTPGet,
+				ResponseData: &syncRecordsResponse{},
+				URLParameters: map[string]Parameter{
+					"type":     "All",
+					"page":     Parameter(strconv.Itoa(page)),
+					"per_page": "200",
+				},
+				Headers: syncHeaders(request.Headers, deletedSince),
+			}
+
+			if err = z.HTTPRequest(&endpoint); err != nil {
+				return fmt.Errorf("Failed to sync deleted records of %s: %s", request.Module, err)
+			}
+
+			resp, ok := endpoint.ResponseData.(*syncRecordsResponse)
+			if !ok {
+				return fmt.Errorf("Data returned was not 'syncRecordsResponse'")
+			}
+
+			for _, raw := range resp.Data {
+				meta := struct {
+					ID          string `json:"id"`
+					DeletedTime string `json:"deleted_time"`
+				}{}
+				if err = json.Unmarshal(raw, &meta); err != nil {
+					return fmt.Errorf("Failed to decode deleted record of %s: %s", request.Module, err)
+				}
+				deleted, err := time.Parse(time.RFC3339, meta.DeletedTime)
+				if err != nil {
+					return fmt.Errorf("Failed to sync deleted records of %s: record %s has no valid deleted_time (%q): %s", request.Module, meta.ID, meta.DeletedTime, err)
+				}
+				if deleted.Before(deletedSince) || seen[meta.ID] {
+					continue
+				}
+				seen[meta.ID] = true
+
+				if err = handler(ChangeEvent{Module: request.Module, Operation: ChangeDelete, ID: meta.ID, Time: deleted, Record: raw}); err != nil {
+					return err
+				}
+				if deleted.After(deletedWatermark) {
+					deletedWatermark = deleted
+				}
+			}
+
+			if !resp.Info.MoreRecords {
+				break
+			}
+		}
+	}
+
+	if watermark.After(since) {
+		if err = request.Store.SaveWatermark(request.Key, watermark); err != nil {
+			return fmt.Errorf("Failed to save watermark of %s: %s", request.Module, err)
+		}
+	}
+	if deletedWatermark.After(deletedSince) {
+		if err = request.Store.SaveWatermark(deletedKey, deletedWatermark); err != nil {
+			return fmt.Errorf("Failed to save watermark of the deleted records of %s: %s", request.Module, err)
+		}
+	}
+
+	return nil
+}
+
+// syncFields returns the comma separated list fields with id and Modified_Time added when missing
+func syncFields(fields string) string {
+	list := strings.Split(fields, ",")
+	for _, required := range []string{"id", "Modified_Time"} {
+		found := false
+		for _, f := range list {
+			if strings.TrimSpace(f) == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, required)
+		}
+	}
+	return strings.Join(list, ",")
+}
+
+// syncHeaders adds the If-Modified-Since header to headers when since is set
+func syncHeaders(headers map[string]string, since time.Time) map[string]string {
+	h := make(map[string]string, len(headers)+1)
+	for k, v := range headers {
+		h[k] = v
+	}
+	if !since.IsZero() {
+		h["If-Modified-Since"] = since.Format(time.RFC3339)
+	}
+	return h
+}
+
+// syncRecordsResponse is the data returned by the list and deleted records endpoints, a 304 response has no body
+type syncRecordsResponse struct {
+	Data []json.RawMessage `json:"data"`
+	Info struct {
+		MoreRecords bool `json:"more_records"`
+	} `json:"info"`
+}
@@ -0,0 +1,381 @@
+package zoho
+
+import (
+	"fmt"
+	"time"
+)
+
+// TagsAPI implements the tags endpoints which CRM and Recruit share, the products only differ by the API root.
+// The crm package exposes its methods, the recruit package builds its requests with the Endpoint methods and
+// decodes them into its own types.
+type TagsAPI struct {
+	*Zoho
+	// BaseURL is the API root of the product, eg. "https://www.zohoapis.com/crm/v2"
+	BaseURL string
+}
+
+// CreateTagsEndpoint returns the endpoint creating the tags of request, the 'module' parameter is mandatory
+func (t TagsAPI) CreateTagsEndpoint(request interface{}, params map[string]Parameter, response interface{}) Endpoint {
+	endpoint := Endpoint{
+		Name:         "tags",
+		URL:          fmt.Sprintf("%s/settings/tags", t.BaseURL),
+		Method:       HTTPPost,
+		ResponseData: response,
+		RequestBody:  request,
+		BodyFormat:   JSON,
+		URLParameters: map[string]Parameter{
+			"module": "", // mandatory
+		},
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+	return endpoint
+}
+
+// GetTagsEndpoint returns the endpoint listing the tags of module
+func (t TagsAPI) GetTagsEndpoint(module string, params map[string]Parameter, response interface{}) Endpoint {
+	endpoint := Endpoint{
+		Name:         "tags",
+		URL:          fmt.Sprintf("%s/settings/tags", t.BaseURL),
+		Method:       HTTPGet,
+		ResponseData: response,
+		URLParameters: map[string]Parameter{
+			"module":  Parameter(module), // mandatory
+			"my_tags": "",
+		},
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+	return endpoint
+}
+
+// UpdateTagEndpoint returns the endpoint renaming the tag ID
+func (t TagsAPI) UpdateTagEndpoint(ID string, request interface{}, params map[string]Parameter, response interface{}) Endpoint {
+	endpoint := Endpoint{
+		Name:          "tags",
+		URL:           fmt.Sprintf("%s/settings/tags/%s", t.BaseURL, ID),
+		Method:        HTTPPut,
+		ResponseData:  response,
+		RequestBody:   request,
+		BodyFormat:    JSON,
+		URLParameters: map[string]Parameter{},
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+	return endpoint
+}
+
+// DeleteTagEndpoint returns the endpoint deleting the tag ID
+func (t TagsAPI) DeleteTagEndpoint(ID string, response interface{}) Endpoint {
+	return Endpoint{
+		Name:         "tags",
+		URL:          fmt.Sprintf("%s/settings/tags/%s", t.BaseURL, ID),
+		Method:       HTTPDelete,
+		ResponseData: response,
+	}
+}
+
+// MergeTagsEndpoint returns the endpoint merging the tag conflictID into the tag ID
+func (t TagsAPI) MergeTagsEndpoint(ID string, conflictID string, response interface{}) Endpoint {
+	return Endpoint{
+		Name:         "tags",
+		URL:          fmt.Sprintf("%s/settings/tags/%s/actions/merge", t.BaseURL, ID),
+		Method:       HTTPPost,
+		ResponseData: response,
+		RequestBody: MergeTagsRequest{
+			Tags: []MergeTag{{ConflictID: conflictID}},
+		},
+		BodyFormat: JSON,
+	}
+}
+
+// TagRecordCountEndpoint returns the endpoint counting the records of module tagged with the tag ID
+func (t TagsAPI) TagRecordCountEndpoint(module string, ID string, response interface{}) Endpoint {
+	return Endpoint{
+		Name:         "tags",
+		URL:          fmt.Sprintf("%s/settings/tags/%s/actions/records_count", t.BaseURL, ID),
+		Method:       HTTPGet,
+		ResponseData: response,
+		URLParameters: map[string]Parameter{
+			"module": Parameter(module),
+		},
+	}
+}
+
+// RecordTagsEndpoint returns the endpoint of action, "add_tags" or "remove_tags", on the record ID of module.
+// When ID is empty the action applies to the records of the 'ids' parameter.
+func (t TagsAPI) RecordTagsEndpoint(action string, module string, ID string, params map[string]Parameter, response interface{}) Endpoint {
+	endpoint := Endpoint{
+		Name:         "tags",
+		URL:          fmt.Sprintf("%s/%s/%s/actions/%s", t.BaseURL, module, ID, action),
+		Method:       HTTPPost,
+		ResponseData: response,
+		URLParameters: map[string]Parameter{
+			"tag_names": "",
+		},
+	}
+	if ID == "" {
+		endpoint.URL = fmt.Sprintf("%s/%s/actions/%s", t.BaseURL, module, action)
+		endpoint.URLParameters["ids"] = ""
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+	return endpoint
+}
+
+// CreateTags creates the tags in request, the 'module' parameter is mandatory
+func (t TagsAPI) CreateTags(request CreateTagsRequest, params map[string]Parameter) (data CreateTagsResponse, err error) {
+	endpoint := t.CreateTagsEndpoint(request, params, &CreateTagsResponse{})
+
+	err = t.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CreateTagsResponse{}, fmt.Errorf("Failed to create tags: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CreateTagsResponse); ok {
+		for _, resp := range v.Tags {
+			if resp.Code != "SUCCESS" {
+				return CreateTagsResponse{}, fmt.Errorf("Failed to create tags: %s: %s", resp.Code, resp.Message)
+			}
+		}
+		return *v, nil
+	}
+
+	return CreateTagsResponse{}, fmt.Errorf("Data returned was not 'CreateTagsResponse'")
+}
+
+// GetTags returns the tags of module, the 'my_tags' parameter limits the list to the tags of the current user
+func (t TagsAPI) GetTags(module string, params map[string]Parameter) (data TagsListResponse, err error) {
+	if len(module) == 0 {
+		return TagsListResponse{}, fmt.Errorf("Failed to list tags, module name is missing")
+	}
+
+	endpoint := t.GetTagsEndpoint(module, params, &TagsListResponse{})
+
+	err = t.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TagsListResponse{}, fmt.Errorf("Failed to retrieve tags of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TagsListResponse); ok {
+		return *v, nil
+	}
+
+	return TagsListResponse{}, fmt.Errorf("Data returned was not 'TagsListResponse'")
+}
+
+// UpdateTag renames the tag ID, CRM requires the 'module' parameter
+func (t TagsAPI) UpdateTag(ID string, request UpdateTagRequest, params map[string]Parameter) (data UpdateTagResponse, err error) {
+	endpoint := t.UpdateTagEndpoint(ID, request, params, &UpdateTagResponse{})
+
+	err = t.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return UpdateTagResponse{}, fmt.Errorf("Failed to update tag: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*UpdateTagResponse); ok {
+		return *v, nil
+	}
+
+	return UpdateTagResponse{}, fmt.Errorf("Data returned was not 'UpdateTagResponse'")
+}
+
+// DeleteTag deletes the tag ID, it is removed from every record it was added to
+func (t TagsAPI) DeleteTag(ID string) (data DeleteTagResponse, err error) {
+	if len(ID) == 0 {
+		return DeleteTagResponse{}, fmt.Errorf("Failed to delete tag, must provide tagID")
+	}
+
+	endpoint := t.DeleteTagEndpoint(ID, &DeleteTagResponse{})
+
+	err = t.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return DeleteTagResponse{}, fmt.Errorf("Failed to delete tag: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*DeleteTagResponse); ok {
+		return *v, nil
+	}
+
+	return DeleteTagResponse{}, fmt.Errorf("Data returned was not 'DeleteTagResponse'")
+}
+
+// MergeTags merges the tag conflictID into the tag ID, the records of conflictID are tagged with ID
+// and conflictID is deleted
+func (t TagsAPI) MergeTags(ID string, conflictID string) (data UpdateTagResponse, err error) {
+	endpoint := t.MergeTagsEndpoint(ID, conflictID, &UpdateTagResponse{})
+
+	err = t.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return UpdateTagResponse{}, fmt.Errorf("Failed to merge tags: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*UpdateTagResponse); ok {
+		return *v, nil
+	}
+
+	return UpdateTagResponse{}, fmt.Errorf("Data returned was not 'UpdateTagResponse'")
+}
+
+// GetTagRecordCount returns the number of records of module tagged with the tag ID
+func (t TagsAPI) GetTagRecordCount(module string, ID string) (data TagRecordCountResponse, err error) {
+	endpoint := t.TagRecordCountEndpoint(module, ID, &TagRecordCountResponse{})
+
+	err = t.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TagRecordCountResponse{}, fmt.Errorf("Failed to retrieve record count of tag: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TagRecordCountResponse); ok {
+		return *v, nil
+	}
+
+	return TagRecordCountResponse{}, fmt.Errorf("Data returned was not 'TagRecordCountResponse'")
+}
+
+// AddTagsToIDs adds tags to records of module, the 'tag_names' and 'ids' parameters are comma separated lists,
+// 'over_write' replaces the existing tags of the records
+func (t TagsAPI) AddTagsToIDs(module string, params map[string]Parameter) (data AddTagsResponse, err error) {
+	return t.recordTags("add_tags", module, "", params)
+}
+
+// AddTagsToID adds tags to the record ID of module, the 'tag_names' parameter is a comma separated list
+func (t TagsAPI) AddTagsToID(module string, ID string, params map[string]Parameter) (data AddTagsResponse, err error) {
+	return t.recordTags("add_tags", module, ID, params)
+}
+
+// RemoveTagsFromIDs removes tags from records of module, the 'tag_names' and 'ids' parameters are comma separated lists
+func (t TagsAPI) RemoveTagsFromIDs(module string, params map[string]Parameter) (data AddTagsResponse, err error) {
+	return t.recordTags("remove_tags", module, "", params)
+}
+
+// RemoveTagsFromID removes tags from the record ID of module, the 'tag_names' parameter is a comma separated list
+func (t TagsAPI) RemoveTagsFromID(module string, ID string, params map[string]Parameter) (data AddTagsResponse, err error) {
+	return t.recordTags("remove_tags", module, ID, params)
+}
+
+// recordTags performs action on the records of module, the status of every record is checked for a single record
+func (t TagsAPI) recordTags(action string, module string, ID string, params map[string]Parameter) (data AddTagsResponse, err error) {
+	failure := fmt.Sprintf("Failed to add tags to %s", module)
+	if action == "remove_tags" {
+		failure = fmt.Sprintf("Failed to remove tags from %s", module)
+	}
+
+	endpoint := t.RecordTagsEndpoint(action, module, ID, params, &AddTagsResponse{})
+
+	err = t.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return AddTagsResponse{}, fmt.Errorf("%s: %s", failure, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*AddTagsResponse); ok {
+		if ID != "" {
+			for _, resp := range v.Data {
+				if resp.Code != "SUCCESS" {
+					return AddTagsResponse{}, fmt.Errorf("%s: %s: %s", failure, resp.Code, resp.Message)
+				}
+			}
+		}
+		return *v, nil
+	}
+
+	return AddTagsResponse{}, fmt.Errorf("Data returned was not 'AddTagsResponse'")
+}
+
+// Tag is the name of a tag to create
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// CreateTagsRequest is the data provided to CreateTags
+type CreateTagsRequest struct {
+	Tags []Tag `json:"tags"`
+}
+
+// UpdateTagRequest is the data provided to UpdateTag
+type UpdateTagRequest struct {
+	Tags []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+}
+
+// MergeTagsRequest is the data sent by MergeTags
+type MergeTagsRequest struct {
+	Tags []MergeTag `json:"tags"`
+}
+
+// MergeTag identifies the tag merged into another
+type MergeTag struct {
+	ConflictID string `json:"conflict_id"`
+}
+
+// TagUser is the user who created or modified a tag
+type TagUser struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// TagDetails are the details of a tag
+type TagDetails struct {
+	CreatedTime  time.Time `json:"created_time"`
+	ModifiedTime time.Time `json:"modified_time"`
+	ModifiedBy   TagUser   `json:"modified_by"`
+	Name         string    `json:"name"`
+	ID           string    `json:"id"`
+	CreatedBy    TagUser   `json:"created_by"`
+}
+
+// TagStatus is the status of a tag returned when creating, updating, merging or deleting tags
+type TagStatus struct {
+	Code    string     `json:"code"`
+	Details TagDetails `json:"details"`
+	Message string     `json:"message"`
+	Status  string     `json:"status"`
+}
+
+// CreateTagsResponse is the data returned by CreateTags
+type CreateTagsResponse struct {
+	Tags []TagStatus `json:"tags"`
+}
+
+// UpdateTagResponse is the data returned by UpdateTag and MergeTags
+type UpdateTagResponse struct {
+	Tags []TagStatus `json:"tags"`
+}
+
+// DeleteTagResponse is the data returned by DeleteTag
+type DeleteTagResponse struct {
+	Tags TagStatus `json:"tags"`
+}
+
+// TagsListResponse is the data returned by GetTags
+type TagsListResponse struct {
+	Tags []TagDetails `json:"tags"`
+	Info struct {
+		Count        int  `json:"count"`
+		AllowedCount int  `json:"allowed_count"`
+		MoreRecords  bool `json:"more_records"`
+	} `json:"info"`
+}
+
+// TagRecordCountResponse is the data returned by GetTagRecordCount
+type TagRecordCountResponse struct {
+	Count string `json:"count"`
+}
+
+// AddTagsResponse is the data returned when adding tags to or removing tags from records
+type AddTagsResponse struct {
+	Data []struct {
+		Code    string `json:"code"`
+		Details struct {
+			ID   string   `json:"id"`
+			Tags []string `json:"tags"`
+		} `json:"details"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+	} `json:"data"`
+}
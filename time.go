@@ -12,18 +12,18 @@ type Time time.Time
 var zohoTimeLayout = "2006-01-02T15:04:05-07:00"
 
 // MarshalJSON is the json marshalling function for Time internal type
-func (t *Time) MarshalJSON() ([]byte, error) {
-	if *t == Time(time.Time{}) {
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t == Time(time.Time{}) {
 		return []byte("null"), nil
 	}
-	stamp := fmt.Sprintf("\"%s\"", time.Time(*t).Format(zohoTimeLayout))
+	stamp := fmt.Sprintf("\"%s\"", time.Time(t).Format(zohoTimeLayout))
 	return []byte(stamp), nil
 }
 
 // UnmarshalJSON is the json unmarshalling function for Time internal type
 func (t *Time) UnmarshalJSON(b []byte) error {
 	s := strings.Trim(string(b), "\"")
-	if s == "null" {
+	if s == "null" || s == "" {
 		*t = Time(time.Time{})
 		return nil
 	}
@@ -40,18 +40,18 @@ type Date time.Time
 var zohoDateLayout = "2006-01-02"
 
 // MarshalJSON is the json marshalling function for Date internal type
-func (d *Date) MarshalJSON() ([]byte, error) {
-	if *d == Date(time.Time{}) {
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d == Date(time.Time{}) {
 		return []byte("null"), nil
 	}
-	stamp := fmt.Sprintf("\"%s\"", time.Time(*d).Format(zohoDateLayout))
+	stamp := fmt.Sprintf("\"%s\"", time.Time(d).Format(zohoDateLayout))
 	return []byte(stamp), nil
 }
 
 // UnmarshalJSON is the json unmarshalling function for Date internal type
 func (d *Date) UnmarshalJSON(b []byte) error {
 	s := strings.Trim(string(b), "\"")
-	if s == "null" {
+	if s == "null" || s == "" {
 		*d = Date(time.Time{})
 		return nil
 	}
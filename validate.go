@@ -0,0 +1,271 @@
+package zoho
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ValidationMode selects the checks made by a Validator
+type ValidationMode int
+
+const (
+	// ValidateInsert - every required field must be provided
+	ValidateInsert ValidationMode = iota
+	// ValidateUpdate - the record id must be provided and required fields may not be cleared
+	ValidateUpdate
+)
+
+// FieldRule is the field metadata a Validator checks values against, it is built from the
+// fields metadata of CRM or Recruit
+type FieldRule struct {
+	APIName string
+	// DataType is the data_type of the field metadata, eg. "text", "picklist", "currency", "lookup"
+	DataType string
+	// Length is the maximum number of characters of text fields, zero means unlimited
+	Length   int
+	Required bool
+	ReadOnly bool
+	// PickListValues are the accepted values of picklist and multiselect picklist fields, an empty list accepts any value
+	PickListValues []string
+}
+
+// Validator checks records locally against field metadata, so that mistakes are found before a request
+// is sent rather than reported by Zoho one record at a time
+type Validator struct {
+	Module string
+	Fields map[string]FieldRule
+}
+
+// NewValidator returns a *Validator for module using rules
+func NewValidator(module string, rules []FieldRule) *Validator {
+	v := &Validator{Module: module, Fields: make(map[string]FieldRule, len(rules))}
+	for _, r := range rules {
+		v.Fields[r.APIName] = r
+	}
+	return v
+}
+
+// ValidationErrors are the field errors found by a Validator, they use the codes returned by Zoho
+// (MANDATORY_NOT_FOUND, INVALID_DATA) and APIName mirrors 'details.api_name'
+type ValidationErrors []*RecordError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%d invalid fields: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// Validate checks every record of records, a slice of record structs or maps, as it would be encoded
+// in a request by Marshal, unset Optional fields are not checked. It returns nil or ValidationErrors.
+func (v *Validator) Validate(records interface{}, mode ValidationMode) error {
+	b, err := Marshal(records)
+	if err != nil {
+		return fmt.Errorf("Failed to validate records of %s: %s", v.Module, err)
+	}
+
+	var raw []map[string]json.RawMessage
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return fmt.Errorf("Failed to validate records of %s, records must be a slice of objects: %s", v.Module, err)
+	}
+
+	var errs ValidationErrors
+	for i, record := range raw {
+		errs = append(errs, v.validateRecord(i, record, mode)...)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateRecord checks a single record struct or map, index is reported in the errors
+func (v *Validator) ValidateRecord(index int, record interface{}, mode ValidationMode) error {
+	b, err := Marshal(record)
+	if err != nil {
+		return fmt.Errorf("Failed to validate record of %s: %s", v.Module, err)
+	}
+
+	raw := map[string]json.RawMessage{}
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return fmt.Errorf("Failed to validate record of %s, record must be an object: %s", v.Module, err)
+	}
+
+	if errs := v.validateRecord(index, raw, mode); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (v *Validator) validateRecord(index int, record map[string]json.RawMessage, mode ValidationMode) ValidationErrors {
+	var errs ValidationErrors
+	fail := func(code, apiName, expected, msg string) {
+		errs = append(errs, &RecordError{Index: index, Code: code, Message: msg, APIName: apiName, ExpectedDataType: expected})
+	}
+
+	if mode == ValidateUpdate && isEmptyJSON(record["id"]) {
+		fail("MANDATORY_NOT_FOUND", "id", "", "the id of the record is required for updates")
+	}
+
+	for _, name := range sortedKeys(v.Fields) {
+		rule := v.Fields[name]
+		value, ok := record[name]
+		if !rule.Required {
+			continue
+		}
+		if mode == ValidateInsert && (!ok || isEmptyJSON(value)) {
+			fail("MANDATORY_NOT_FOUND", name, "", "required field not found")
+		}
+		if mode == ValidateUpdate && ok && isEmptyJSON(value) {
+			fail("MANDATORY_NOT_FOUND", name, "", "a required field cannot be cleared")
+		}
+	}
+
+	for _, name := range sortedKeys(record) {
+		value := record[name]
+		rule, ok := v.Fields[name]
+		if !ok || name == "id" || isEmptyJSON(value) {
+			continue
+		}
+		if rule.ReadOnly {
+			fail("INVALID_DATA", name, "", "the field is read only")
+			continue
+		}
+		if msg := checkFieldValue(rule, value); msg != "" {
+			fail("INVALID_DATA", name, rule.DataType, msg)
+		}
+	}
+
+	return errs
+}
+
+// checkFieldValue returns why value is not valid for rule, or an empty string
+func checkFieldValue(rule FieldRule, value json.RawMessage) string {
+	switch rule.DataType {
+	case "text", "textarea", "email", "phone", "website", "picklist", "autonumber":
+		var s string
+		if json.Unmarshal(value, &s) != nil {
+			return "invalid data, expected a string"
+		}
+		if rule.Length > 0 && utf8.RuneCountInString(s) > rule.Length {
+			return fmt.Sprintf("the value exceeds the maximum length of %d", rule.Length)
+		}
+		if rule.DataType == "email" && s != "" && !strings.Contains(s, "@") {
+			return "invalid email address"
+		}
+		if rule.DataType == "picklist" && s != "" && !inPickList(rule.PickListValues, s) {
+			return fmt.Sprintf("'%s' is not a value of the picklist", s)
+		}
+
+	case "multiselectpicklist":
+		var values []string
+		if json.Unmarshal(value, &values) != nil {
+			return "invalid data, expected an array of strings"
+		}
+		for _, s := range values {
+			if !inPickList(rule.PickListValues, s) {
+				return fmt.Sprintf("'%s' is not a value of the picklist", s)
+			}
+		}
+
+	case "integer", "bigint":
+		var n json.Number
+		if json.Unmarshal(value, &n) != nil {
+			return "invalid data, expected an integer"
+		}
+		if _, err := n.Int64(); err != nil {
+			return "invalid data, expected an integer"
+		}
+
+	case "double", "currency", "percent", "decimal":
+		var n json.Number
+		if json.Unmarshal(value, &n) != nil {
+			return "invalid data, expected a number"
+		}
+		if _, err := n.Float64(); err != nil {
+			return "invalid data, expected a number"
+		}
+
+	case "boolean":
+		var b bool
+		if json.Unmarshal(value, &b) != nil {
+			return "invalid data, expected a boolean"
+		}
+
+	case "date":
+		var s string
+		if json.Unmarshal(value, &s) != nil {
+			return "invalid data, expected a date"
+		}
+		if _, err := time.Parse("2006-01-02", s); err != nil {
+			return "invalid data, expected a date formatted as yyyy-MM-dd"
+		}
+
+	case "datetime":
+		var s string
+		if json.Unmarshal(value, &s) != nil {
+			return "invalid data, expected a date time"
+		}
+		if _, err := time.Parse(time.RFC3339, s); err != nil {
+			return "invalid data, expected a date time formatted as yyyy-MM-ddTHH:mm:ss±HH:mm"
+		}
+
+	case "lookup", "ownerlookup", "userlookup":
+		var s string
+		if json.Unmarshal(value, &s) == nil {
+			return ""
+		}
+		lookup := struct {
+			ID string `json:"id"`
+		}{}
+		if json.Unmarshal(value, &lookup) != nil || lookup.ID == "" {
+			return "invalid data, expected a lookup holding an id"
+		}
+	}
+
+	return ""
+}
+
+// sortedKeys returns the keys of m in order, so errors are reported in a stable order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func inPickList(values []string, s string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isNullJSON(value json.RawMessage) bool {
+	return len(value) == 0 || bytes.Equal(value, []byte("null"))
+}
+
+// isEmptyJSON reports whether value is missing, null or an empty string, array or object
+func isEmptyJSON(value json.RawMessage) bool {
+	if isNullJSON(value) {
+		return true
+	}
+	switch string(value) {
+	case `""`, `[]`, `{}`:
+		return true
+	}
+	return false
+}